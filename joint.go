@@ -0,0 +1,151 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"io"
+	"math"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// JointPlot is a composite of a central plot, such as a scatter
+// plot or a two-dimensional histogram, and marginal plots along
+// its top and right edges, such as histograms of the X and Y
+// values. The marginal plots share the range of the axis of the
+// central plot that they are beside, and their data areas are
+// aligned with the data area of the central plot.
+type JointPlot struct {
+	// Center is the central plot.
+	Center *Plot
+
+	// Top is the marginal plot above the central
+	// plot, sharing its X axis range and scale.
+	Top *Plot
+
+	// Right is the marginal plot to the right of
+	// the central plot, sharing its Y axis range
+	// and scale. Horizontal plotters, such as a
+	// Horizontal histogram, are drawn in it.
+	Right *Plot
+
+	// TopSize and RightSize are the fractions of the
+	// height and width of the canvas given to the
+	// Top and Right marginal plots.
+	TopSize, RightSize float64
+}
+
+// NewJointPlot returns a JointPlot with new central and
+// marginal plots. The axes of the marginal plots that are
+// shared with the central plot are hidden, and each marginal
+// plot is given a fifth of the canvas.
+func NewJointPlot() (*JointPlot, error) {
+	center, err := New()
+	if err != nil {
+		return nil, err
+	}
+	top, err := New()
+	if err != nil {
+		return nil, err
+	}
+	top.HideX()
+	right, err := New()
+	if err != nil {
+		return nil, err
+	}
+	right.HideY()
+	return &JointPlot{
+		Center:    center,
+		Top:       top,
+		Right:     right,
+		TopSize:   0.2,
+		RightSize: 0.2,
+	}, nil
+}
+
+// shareRanges sets the ranges of the shared axes of the
+// central and marginal plots to the union of their ranges,
+// and the scales of the marginal axes to those of the
+// central plot.
+func (j *JointPlot) shareRanges() {
+	j.Center.X.Min = math.Min(j.Center.X.Min, j.Top.X.Min)
+	j.Center.X.Max = math.Max(j.Center.X.Max, j.Top.X.Max)
+	j.Top.X.Min, j.Top.X.Max = j.Center.X.Min, j.Center.X.Max
+	j.Top.X.Scale = j.Center.X.Scale
+
+	j.Center.Y.Min = math.Min(j.Center.Y.Min, j.Right.Y.Min)
+	j.Center.Y.Max = math.Max(j.Center.Y.Max, j.Right.Y.Max)
+	j.Right.Y.Min, j.Right.Y.Max = j.Center.Y.Min, j.Center.Y.Max
+	j.Right.Y.Scale = j.Center.Y.Scale
+}
+
+// Canvases returns the canvases of the central and marginal plots
+// within c, with the data areas of the marginal plots aligned with
+// the data area of the central plot. The ranges of the shared axes
+// are linked before the layout is calculated.
+func (j *JointPlot) Canvases(c draw.Canvas) (center, top, right draw.Canvas) {
+	j.shareRanges()
+
+	size := c.Size()
+	topH := vg.Length(j.TopSize) * size.Y
+	rightW := vg.Length(j.RightSize) * size.X
+	center = draw.Crop(c, 0, -rightW, 0, -topH)
+	top = draw.Crop(c, 0, -rightW, size.Y-topH, 0)
+	right = draw.Crop(c, size.X-rightW, 0, 0, -topH)
+
+	// Pad the central and top canvases so their
+	// data areas have the same horizontal extent.
+	cd, td := j.Center.DataCanvas(center), j.Top.DataCanvas(top)
+	left := maxLength(cd.Min.X-center.Min.X, td.Min.X-top.Min.X)
+	rgt := maxLength(center.Max.X-cd.Max.X, top.Max.X-td.Max.X)
+	center = draw.Crop(center, left-(cd.Min.X-center.Min.X), (center.Max.X-cd.Max.X)-rgt, 0, 0)
+	top = draw.Crop(top, left-(td.Min.X-top.Min.X), (top.Max.X-td.Max.X)-rgt, 0, 0)
+
+	// Pad the central and right canvases so their
+	// data areas have the same vertical extent.
+	cd, rd := j.Center.DataCanvas(center), j.Right.DataCanvas(right)
+	bottom := maxLength(cd.Min.Y-center.Min.Y, rd.Min.Y-right.Min.Y)
+	up := maxLength(center.Max.Y-cd.Max.Y, right.Max.Y-rd.Max.Y)
+	center = draw.Crop(center, 0, 0, bottom-(cd.Min.Y-center.Min.Y), (center.Max.Y-cd.Max.Y)-up)
+	right = draw.Crop(right, 0, 0, bottom-(rd.Min.Y-right.Min.Y), (right.Max.Y-rd.Max.Y)-up)
+
+	return center, top, right
+}
+
+// maxLength returns the larger of two lengths.
+func maxLength(a, b vg.Length) vg.Length {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Draw draws the central and marginal plots to the canvas.
+func (j *JointPlot) Draw(c draw.Canvas) {
+	center, top, right := j.Canvases(c)
+	j.Center.Draw(center)
+	j.Top.Draw(top)
+	j.Right.Draw(right)
+}
+
+// WriterTo returns an io.WriterTo that will write the joint
+// plot as the specified image format, as for Plot.WriterTo.
+func (j *JointPlot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
+	c, err := draw.NewFormattedCanvas(w, h, format)
+	if err != nil {
+		return nil, err
+	}
+	j.Draw(draw.New(c))
+	return c, nil
+}
+
+// Save saves the joint plot to an image file. The file
+// format is determined by the extension, as for Plot.Save.
+func (j *JointPlot) Save(w, h vg.Length, file string) error {
+	return save(file, func(format string) (io.WriterTo, error) {
+		return j.WriterTo(w, h, format)
+	})
+}
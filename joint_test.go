@@ -0,0 +1,66 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plot
+
+import (
+	"testing"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestJointPlotCanvases(t *testing.T) {
+	j, err := NewJointPlot()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	j.Center.X.Min, j.Center.X.Max = 0, 10
+	j.Center.Y.Min, j.Center.Y.Max = -5, 5
+	j.Center.X.Label.Text = "X"
+	j.Center.Y.Label.Text = "Y"
+	j.Top.X.Min, j.Top.X.Max = -2, 8
+	j.Top.Y.Min, j.Top.Y.Max = 0, 1000
+	j.Right.X.Min, j.Right.X.Max = 0, 1e6
+	j.Right.Y.Min, j.Right.Y.Max = -1, 1
+	j.TopSize = 0.3
+	j.RightSize = 0.1
+
+	c := draw.NewCanvas(&recorder.Canvas{}, 200, 100)
+	center, top, right := j.Canvases(c)
+
+	if j.Center.X.Min != -2 || j.Center.X.Max != 10 || j.Top.X.Min != -2 || j.Top.X.Max != 10 {
+		t.Errorf("X ranges not shared: center:[%v, %v] top:[%v, %v]",
+			j.Center.X.Min, j.Center.X.Max, j.Top.X.Min, j.Top.X.Max)
+	}
+	if j.Center.Y.Min != -5 || j.Center.Y.Max != 5 || j.Right.Y.Min != -5 || j.Right.Y.Max != 5 {
+		t.Errorf("Y ranges not shared: center:[%v, %v] right:[%v, %v]",
+			j.Center.Y.Min, j.Center.Y.Max, j.Right.Y.Min, j.Right.Y.Max)
+	}
+
+	const tol = 1e-9
+	near := func(a, b vg.Length) bool { return a-b < tol && b-a < tol }
+	cd := j.Center.DataCanvas(center)
+	td := j.Top.DataCanvas(top)
+	rd := j.Right.DataCanvas(right)
+	if !near(cd.Min.X, td.Min.X) || !near(cd.Max.X, td.Max.X) {
+		t.Errorf("top data area not aligned: center:[%v, %v] top:[%v, %v]", cd.Min.X, cd.Max.X, td.Min.X, td.Max.X)
+	}
+	if !near(cd.Min.Y, rd.Min.Y) || !near(cd.Max.Y, rd.Max.Y) {
+		t.Errorf("right data area not aligned: center:[%v, %v] right:[%v, %v]", cd.Min.Y, cd.Max.Y, rd.Min.Y, rd.Max.Y)
+	}
+	if top.Min.Y < center.Max.Y || right.Min.X < center.Max.X {
+		t.Errorf("marginal canvases overlap central canvas: center:%v top:%v right:%v", center.Rectangle, top.Rectangle, right.Rectangle)
+	}
+	if top.Max.Y != 100 || right.Max.X != 200 {
+		t.Errorf("marginal canvases do not reach edges: top:%v right:%v", top.Rectangle, right.Rectangle)
+	}
+	if h := top.Max.Y - top.Min.Y; !near(h, 30) {
+		t.Errorf("unexpected top canvas height: got:%v want:30", h)
+	}
+	if w := right.Max.X - right.Min.X; !near(w, 20) {
+		t.Errorf("unexpected right canvas width: got:%v want:20", w)
+	}
+}
@@ -451,7 +451,15 @@ func (p *Plot) WriterTo(w, h vg.Length, format string) (io.WriterTo, error) {
 // Supported extensions are:
 //
 //  .eps, .jpg, .jpeg, .pdf, .png, .svg, .tif and .tiff.
-func (p *Plot) Save(w, h vg.Length, file string) (err error) {
+func (p *Plot) Save(w, h vg.Length, file string) error {
+	return save(file, func(format string) (io.WriterTo, error) {
+		return p.WriterTo(w, h, format)
+	})
+}
+
+// save writes the io.WriterTo returned by writerTo for the
+// format given by the extension of the file to the file.
+func save(file string, writerTo func(format string) (io.WriterTo, error)) (err error) {
 	f, err := os.Create(file)
 	if err != nil {
 		return err
@@ -467,7 +475,7 @@ func (p *Plot) Save(w, h vg.Length, file string) (err error) {
 	if len(format) != 0 {
 		format = format[1:]
 	}
-	c, err := p.WriterTo(w, h, format)
+	c, err := writerTo(format)
 	if err != nil {
 		return err
 	}
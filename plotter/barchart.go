@@ -6,6 +6,7 @@ package plotter
 
 import (
 	"errors"
+	"fmt"
 	"image/color"
 	"math"
 
@@ -45,9 +46,46 @@ type BarChart struct {
 	// locations and distances.
 	Horizontal bool
 
+	// LabelFormat is the format, as used by fmt.Sprintf,
+	// of the value labels written beside each bar. If
+	// LabelFormat is empty, no value labels are drawn.
+	LabelFormat string
+
+	// LabelStyle is the style of the value label text.
+	// The alignment of the text is set according to
+	// the position of the label.
+	LabelStyle draw.TextStyle
+
+	// LabelInside specifies that the value labels are
+	// placed inside the end of each bar. Otherwise the
+	// labels are placed beyond the end of each bar and
+	// its error bar.
+	LabelInside bool
+
+	// YErrors holds the error of each bar value. If
+	// YErrors has an error for each value, an error
+	// bar is drawn at the end of each bar, centered
+	// across its width. Otherwise no error bars are
+	// drawn. For horizontal bar charts the error bars
+	// are drawn horizontally.
+	YErrors YErrors
+
+	// ErrorStyle is the style used to draw the error bars.
+	ErrorStyle draw.LineStyle
+
+	// CapWidth is the width of the caps drawn at the
+	// ends of each error bar.
+	CapWidth vg.Length
+
 	// stackedOn is the bar chart upon which
 	// this bar chart is stacked.
 	stackedOn *BarChart
+
+	// signed specifies that positive and negative
+	// values are stacked separately, so that each
+	// bar starts from the sum of the values of the
+	// same sign beneath it.
+	signed bool
 }
 
 // NewBarChart returns a new bar chart with a single bar for each value.
@@ -61,22 +99,39 @@ func NewBarChart(vs Valuer, width vg.Length) (*BarChart, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The default label font is only set if it is
+	// available, since it is only needed for labels.
+	fnt, _ := vg.MakeFont(DefaultFont, DefaultFontSize)
 	return &BarChart{
-		Values:    values,
-		Width:     width,
-		Color:     color.Black,
-		LineStyle: DefaultLineStyle,
+		Values:     values,
+		Width:      width,
+		Color:      color.Black,
+		LineStyle:  DefaultLineStyle,
+		LabelStyle: draw.TextStyle{Font: fnt},
+		ErrorStyle: DefaultLineStyle,
+		CapWidth:   DefaultCapWidth,
 	}, nil
 }
 
 // BarHeight returns the maximum y value of the
 // ith bar, taking into account any bars upon
 // which it is stacked.
+//
+// If positive and negative values are stacked
+// separately, BarHeight returns the value at the
+// end of the ith bar, which is below its start
+// for a negative value.
 func (b *BarChart) BarHeight(i int) float64 {
 	ht := 0.0
 	if b == nil {
 		return 0
 	}
+	if b.signed {
+		if i >= 0 && i < len(b.Values) {
+			ht = b.Values[i]
+		}
+		return b.stackBase(i) + ht
+	}
 	if i >= 0 && i < len(b.Values) {
 		ht += b.Values[i]
 	}
@@ -89,14 +144,51 @@ func (b *BarChart) BarHeight(i int) float64 {
 // StackOn stacks a bar chart on top of another,
 // and sets the XMin and Offset to that of the
 // chart upon which it is being stacked.
+//
+// If either chart stacks positive and negative
+// values separately, as those of a BarGroup made
+// by NewStackedBars do, all the charts from b
+// down are made to stack them separately, so the
+// bars of the stack are placed by the same rule.
 func (b *BarChart) StackOn(on *BarChart) {
 	b.XMin = on.XMin
 	b.Offset = on.Offset
 	b.stackedOn = on
+	if b.signed || on.signed {
+		for s := b; s != nil; s = s.stackedOn {
+			s.signed = true
+		}
+	}
+}
+
+// stackBase returns the value at which the ith bar
+// starts, taking into account any bars upon which
+// it is stacked.
+func (b *BarChart) stackBase(i int) float64 {
+	if !b.signed {
+		return b.stackedOn.BarHeight(i)
+	}
+	neg := i < len(b.Values) && b.Values[i] < 0
+	base := 0.0
+	for s := b.stackedOn; s != nil; s = s.stackedOn {
+		if i >= len(s.Values) {
+			continue
+		}
+		if v := s.Values[i]; (v < 0) == neg {
+			base += v
+		}
+	}
+	return base
 }
 
 // Plot implements the plot.Plotter interface.
 func (b *BarChart) Plot(c draw.Canvas, plt *plot.Plot) {
+	b.plotBars(c, plt)
+	b.plotAnnotations(c, plt)
+}
+
+// plotBars draws the bars of the bar chart.
+func (b *BarChart) plotBars(c draw.Canvas, plt *plot.Plot) {
 	trCat, trVal := plt.Transforms(&c)
 	if b.Horizontal {
 		trCat, trVal = trVal, trCat
@@ -116,7 +208,7 @@ func (b *BarChart) Plot(c draw.Canvas, plt *plot.Plot) {
 		}
 		catMin = catMin - b.Width/2 + b.Offset
 		catMax := catMin + b.Width
-		bottom := b.stackedOn.BarHeight(i)
+		bottom := b.stackBase(i)
 		valMin := trVal(bottom)
 		valMax := trVal(bottom + ht)
 
@@ -153,6 +245,123 @@ func (b *BarChart) Plot(c draw.Canvas, plt *plot.Plot) {
 	}
 }
 
+// plotAnnotations draws the error bars and value
+// labels of the bar chart.
+func (b *BarChart) plotAnnotations(c draw.Canvas, plt *plot.Plot) {
+	if !b.hasErrors() && b.LabelFormat == "" {
+		return
+	}
+	trCat, trVal := plt.Transforms(&c)
+	if b.Horizontal {
+		trCat, trVal = trVal, trCat
+	}
+	for i, ht := range b.Values {
+		cat := trCat(b.XMin + float64(i))
+		if !b.Horizontal && !c.ContainsX(cat) || b.Horizontal && !c.ContainsY(cat) {
+			continue
+		}
+		cat += b.Offset
+
+		if b.hasErrors() {
+			low, high := b.errorRange(i)
+			b.drawErrorBar(&c, cat, trVal(low), trVal(high))
+		}
+
+		if b.LabelFormat != "" {
+			val, away := b.labelAnchor(i)
+			end := trVal(val)
+			if !b.Horizontal && !c.ContainsY(end) || b.Horizontal && !c.ContainsX(end) {
+				continue
+			}
+			pt := vg.Point{X: cat, Y: end + vg.Length(away)*barLabelPad}
+			sty := b.LabelStyle
+			sty.XAlign = draw.XCenter
+			sty.YAlign = draw.YBottom
+			if away < 0 {
+				sty.YAlign = draw.YTop
+			}
+			if b.Horizontal {
+				pt = vg.Point{X: end + vg.Length(away)*barLabelPad, Y: cat}
+				sty.XAlign = draw.XLeft
+				if away < 0 {
+					sty.XAlign = draw.XRight
+				}
+				sty.YAlign = draw.YCenter
+			}
+			c.FillText(sty, pt, fmt.Sprintf(b.LabelFormat, ht))
+		}
+	}
+}
+
+// barLabelPad is the distance between a bar value
+// label and the point at which it is anchored.
+const barLabelPad = vg.Length(2)
+
+// hasErrors returns whether the bar chart has
+// an error for each value, to be drawn as error
+// bars.
+func (b *BarChart) hasErrors() bool {
+	return len(b.YErrors) == len(b.Values)
+}
+
+// errorRange returns the low and high ends of the
+// error bar of the ith bar.
+func (b *BarChart) errorRange(i int) (low, high float64) {
+	end := b.stackBase(i) + b.Values[i]
+	errLow, errHigh := b.YErrors.YError(i)
+	return end - math.Abs(errLow), end + math.Abs(errHigh)
+}
+
+// labelAnchor returns the value at which the label of
+// the ith bar is anchored and the direction, 1 or -1
+// along the value axis, in which the label extends
+// away from the anchor.
+func (b *BarChart) labelAnchor(i int) (val, away float64) {
+	v := b.Values[i]
+	val = b.stackBase(i) + v
+	away = 1
+	if v < 0 {
+		away = -1
+	}
+	if b.LabelInside {
+		return val, -away
+	}
+	if b.hasErrors() {
+		low, high := b.errorRange(i)
+		if away > 0 {
+			val = math.Max(val, high)
+		} else {
+			val = math.Min(val, low)
+		}
+	}
+	return val, away
+}
+
+// drawErrorBar draws an error bar from low to high along
+// the value axis at the category location cat, with
+// caps at both ends if they are not clipped. Like the
+// bars, the error bar is only clipped along the value
+// axis.
+func (b *BarChart) drawErrorBar(c *draw.Canvas, cat, low, high vg.Length) {
+	if b.Horizontal {
+		bar := []vg.Point{{X: low, Y: cat}, {X: high, Y: cat}}
+		c.StrokeLines(b.ErrorStyle, c.ClipLinesX(bar)...)
+		for _, pt := range bar {
+			if c.ContainsX(pt.X) {
+				c.StrokeLine2(b.ErrorStyle, pt.X, pt.Y-b.CapWidth/2, pt.X, pt.Y+b.CapWidth/2)
+			}
+		}
+		return
+	}
+	bar := []vg.Point{{X: cat, Y: low}, {X: cat, Y: high}}
+	c.StrokeLines(b.ErrorStyle, c.ClipLinesY(bar)...)
+	for _, pt := range bar {
+		if c.ContainsY(pt.Y) {
+			c.StrokeLine2(b.ErrorStyle, pt.X-b.CapWidth/2, pt.Y, pt.X+b.CapWidth/2, pt.Y)
+		}
+	}
+}
+
 // DataRange implements the plot.DataRanger interface.
 func (b *BarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
 	catMin := b.XMin
@@ -161,10 +370,15 @@ func (b *BarChart) DataRange() (xmin, xmax, ymin, ymax float64) {
 	valMin := math.Inf(1)
 	valMax := math.Inf(-1)
 	for i, val := range b.Values {
-		valBot := b.stackedOn.BarHeight(i)
+		valBot := b.stackBase(i)
 		valTop := valBot + val
 		valMin = math.Min(valMin, math.Min(valBot, valTop))
 		valMax = math.Max(valMax, math.Max(valBot, valTop))
+		if b.hasErrors() {
+			low, high := b.errorRange(i)
+			valMin = math.Min(valMin, low)
+			valMax = math.Max(valMax, high)
+		}
 	}
 	if !b.Horizontal {
 		return catMin, catMax, valMin, valMax
@@ -191,6 +405,36 @@ func (b *BarChart) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 			}
 		}
 	}
+	if b.LabelFormat == "" {
+		return boxes
+	}
+	for i, v := range b.Values {
+		cat := b.XMin + float64(i)
+		val, away := b.labelAnchor(i)
+		txt := b.LabelStyle.Rectangle(fmt.Sprintf(b.LabelFormat, v))
+		w, h := txt.Max.X-txt.Min.X, txt.Max.Y-txt.Min.Y
+		var box plot.GlyphBox
+		if !b.Horizontal {
+			box.X, box.Y = plt.X.Norm(cat), plt.Y.Norm(val)
+			box.Rectangle = vg.Rectangle{
+				Min: vg.Point{X: b.Offset - w/2, Y: barLabelPad},
+				Max: vg.Point{X: b.Offset + w/2, Y: barLabelPad + h},
+			}
+			if away < 0 {
+				box.Min.Y, box.Max.Y = -box.Max.Y, -box.Min.Y
+			}
+		} else {
+			box.X, box.Y = plt.X.Norm(val), plt.Y.Norm(cat)
+			box.Rectangle = vg.Rectangle{
+				Min: vg.Point{X: barLabelPad, Y: b.Offset - h/2},
+				Max: vg.Point{X: barLabelPad + w, Y: b.Offset + h/2},
+			}
+			if away < 0 {
+				box.Min.X, box.Max.X = -box.Max.X, -box.Min.X
+			}
+		}
+		boxes = append(boxes, box)
+	}
 	return boxes
 }
 
@@ -13,6 +13,8 @@ import (
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/internal/cmpimg"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 func ExampleBarChart() {
@@ -272,3 +274,105 @@ func ExampleBarChart_positiveNegative() {
 func TestBarChart_positiveNegative(t *testing.T) {
 	cmpimg.CheckPlot(ExampleBarChart_positiveNegative, t, "barChart_positiveNegative.png")
 }
+
+// This example shows a grouped bar chart with value labels
+// and error bars on each bar.
+func ExampleBarChart_valueLabels() {
+	series := []BarSeries{
+		{Name: "Control", Values: Values{20, 35, 30, 35}},
+		{Name: "Treatment", Values: Values{25, 32, 34, 20}},
+	}
+	errs := []YErrors{
+		{{2, 2}, {3, 3}, {1, 1}, {4, 4}},
+		{{1, 1}, {2, 2}, {5, 5}, {2, 2}},
+	}
+
+	g, err := NewGroupedBars(vg.Points(20), nil, series...)
+	if err != nil {
+		log.Panic(err)
+	}
+	g.Bars[0].Color = color.RGBA{R: 196, G: 196, A: 255}
+	g.Bars[1].Color = color.RGBA{B: 255, A: 255}
+	for i, b := range g.Bars {
+		b.LabelFormat = "%.0f"
+		b.YErrors = errs[i]
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Add(g)
+	names, thumbs := g.Thumbnailers()
+	for i, name := range names {
+		p.Legend.Add(name, thumbs[i])
+	}
+	p.Legend.Top = true
+	p.Legend.Left = true
+	p.NominalX("One", "Two", "Three", "Four")
+	p.Y.Max = 50
+	err = p.Save(250, 250, "testdata/barChart_valueLabels.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestBarChart_valueLabels(t *testing.T) {
+	cmpimg.CheckPlot(ExampleBarChart_valueLabels, t, "barChart_valueLabels.png")
+}
+
+func TestBarChartValueLabels(t *testing.T) {
+	b, err := NewBarChart(Values{2, -3}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b.LabelFormat = "%g"
+	b.YErrors = YErrors{{1, 2}, {0.5, 0.25}}
+
+	for _, test := range []struct {
+		inside   bool
+		wantVals []float64
+		wantAway []float64
+	}{
+		{inside: false, wantVals: []float64{4, -3.5}, wantAway: []float64{1, -1}},
+		{inside: true, wantVals: []float64{2, -3}, wantAway: []float64{-1, 1}},
+	} {
+		b.LabelInside = test.inside
+		for i := range b.Values {
+			val, away := b.labelAnchor(i)
+			if val != test.wantVals[i] || away != test.wantAway[i] {
+				t.Errorf("unexpected label anchor for bar %d inside=%t: got:(%v, %v) want:(%v, %v)",
+					i, test.inside, val, away, test.wantVals[i], test.wantAway[i])
+			}
+		}
+	}
+
+	_, _, ymin, ymax := b.DataRange()
+	if ymin != -3.5 || ymax != 4 {
+		t.Errorf("unexpected value range: got:[%v, %v] want:[-3.5, 4]", ymin, ymax)
+	}
+}
+
+func TestBarChartErrorsLength(t *testing.T) {
+	b, err := NewBarChart(Values{2, 3, 4}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b.YErrors = YErrors{{1, 1}}
+
+	// Errors that do not match the values are
+	// ignored rather than indexed out of range.
+	if val, _ := b.labelAnchor(2); val != 4 {
+		t.Errorf("unexpected label anchor: got:%v want:4", val)
+	}
+	if _, _, ymin, ymax := b.DataRange(); ymin != 0 || ymax != 4 {
+		t.Errorf("unexpected value range: got:[%v, %v] want:[0, 4]", ymin, ymax)
+	}
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(b)
+	var rec recorder.Canvas
+	b.Plot(draw.NewCanvas(&rec, 100, 100), p)
+}
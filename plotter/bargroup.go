@@ -0,0 +1,194 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// BarSeries is a named series of values
+// to be presented as one set of bars in a
+// BarGroup.
+type BarSeries struct {
+	// Name is the name of the series, used
+	// for its legend entry.
+	Name string
+
+	// Values are the bar values of the series.
+	Values Valuer
+}
+
+// A BarGroup presents several named series of values as
+// bar charts sharing the same categories. The bars of each
+// category are either placed side by side or stacked on one
+// another.
+type BarGroup struct {
+	// Bars holds the bar chart for each series, in the
+	// order the series were given. The style of the
+	// individual bar charts may be changed before the
+	// group is plotted. If the XMin or Horizontal field
+	// of one bar chart is changed, the same change must
+	// be made to all of them.
+	Bars []*BarChart
+
+	// Names holds the name of each series.
+	Names []string
+
+	// stacked is whether the bars of each
+	// category are stacked on one another.
+	stacked bool
+}
+
+// NewGroupedBars returns a BarGroup with the bars for each category
+// placed side by side, in the order of the series. Each bar has the
+// given width and the group of bars is centered on the X location of
+// its category. The bars of the ith series are filled with the ith
+// color of p, cycling through the colors as needed. If p is nil the
+// bars are filled black.
+func NewGroupedBars(width vg.Length, p palette.Palette, series ...BarSeries) (*BarGroup, error) {
+	g, err := newBarGroup(width, p, series)
+	if err != nil {
+		return nil, err
+	}
+	n := len(g.Bars)
+	for i, b := range g.Bars {
+		b.Offset = vg.Length(float64(i)-float64(n-1)/2) * width
+	}
+	return g, nil
+}
+
+// NewStackedBars returns a BarGroup with the bars for each category
+// stacked on one another, the first series at the bottom. Positive
+// and negative values are stacked separately, so positive values
+// stack upwards from zero and negative values stack downwards from
+// zero. The bars of the ith series are filled with the ith color of
+// p, cycling through the colors as needed. If p is nil the bars are
+// filled black.
+func NewStackedBars(width vg.Length, p palette.Palette, series ...BarSeries) (*BarGroup, error) {
+	g, err := newBarGroup(width, p, series)
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range g.Bars {
+		b.signed = true
+		if i > 0 {
+			b.StackOn(g.Bars[i-1])
+		}
+	}
+	g.stacked = true
+	return g, nil
+}
+
+func newBarGroup(width vg.Length, p palette.Palette, series []BarSeries) (*BarGroup, error) {
+	if len(series) == 0 {
+		return nil, ErrNoData
+	}
+	var colors []color.Color
+	if p != nil {
+		colors = p.Colors()
+	}
+	g := &BarGroup{
+		Bars:  make([]*BarChart, len(series)),
+		Names: make([]string, len(series)),
+	}
+	for i, s := range series {
+		if i > 0 && s.Values.Len() != series[0].Values.Len() {
+			return nil, fmt.Errorf("plotter: bar series %d has %d values, want %d", i, s.Values.Len(), series[0].Values.Len())
+		}
+		b, err := NewBarChart(s.Values, width)
+		if err != nil {
+			return nil, err
+		}
+		if len(colors) != 0 {
+			b.Color = colors[i%len(colors)]
+		}
+		g.Bars[i] = b
+		g.Names[i] = s.Name
+	}
+	return g, nil
+}
+
+// Normalize scales the values of each category so that
+// the absolute values of its bars sum to sum. Calling
+// Normalize(100) on a stacked BarGroup of non-negative
+// values gives a 100% stacked bar chart. Categories
+// whose values are all zero are left unchanged.
+func (g *BarGroup) Normalize(sum float64) {
+	for i := range g.Bars[0].Values {
+		var total float64
+		for _, b := range g.Bars {
+			total += math.Abs(b.Values[i])
+		}
+		if total == 0 {
+			continue
+		}
+		for _, b := range g.Bars {
+			b.Values[i] *= sum / total
+		}
+	}
+}
+
+// Plot implements the plot.Plotter interface. The error
+// bars and value labels of all the series are drawn after
+// their bars, so that they are not hidden by stacked bars.
+func (g *BarGroup) Plot(c draw.Canvas, plt *plot.Plot) {
+	for _, b := range g.Bars {
+		b.plotBars(c, plt)
+	}
+	for _, b := range g.Bars {
+		b.plotAnnotations(c, plt)
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (g *BarGroup) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.Inf(1), math.Inf(1)
+	xmax, ymax = math.Inf(-1), math.Inf(-1)
+	for _, b := range g.Bars {
+		bxmin, bxmax, bymin, bymax := b.DataRange()
+		xmin = math.Min(xmin, bxmin)
+		xmax = math.Max(xmax, bxmax)
+		ymin = math.Min(ymin, bymin)
+		ymax = math.Max(ymax, bymax)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes implements the GlyphBoxer interface.
+func (g *BarGroup) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	for _, b := range g.Bars {
+		boxes = append(boxes, b.GlyphBoxes(plt)...)
+	}
+	return boxes
+}
+
+// Thumbnailers returns the series names and the
+// thumbnailers of their bar charts, to be used to add
+// legend entries for the series of the group. The
+// entries are in the order the series appear in the
+// plot, with the top of the stack first for stacked
+// bars.
+func (g *BarGroup) Thumbnailers() (legendLabels []string, thumbnailers []plot.Thumbnailer) {
+	n := len(g.Bars)
+	legendLabels = make([]string, n)
+	thumbnailers = make([]plot.Thumbnailer, n)
+	for i, b := range g.Bars {
+		j := i
+		if g.stacked {
+			j = n - 1 - i
+		}
+		legendLabels[j] = g.Names[i]
+		thumbnailers[j] = b
+	}
+	return legendLabels, thumbnailers
+}
@@ -0,0 +1,147 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+)
+
+// This example shows grouped and stacked bar charts built
+// from several named series.
+func ExampleBarGroup() {
+	series := []BarSeries{
+		{Name: "A", Values: Values{20, 35, 30, 35, 27}},
+		{Name: "B", Values: Values{25, -32, 34, 20, -25}},
+		{Name: "C", Values: Values{12, 28, -15, 21, 8}},
+	}
+	pal := palette.Rainbow(len(series), palette.Red, palette.Blue, 1, 1, 1)
+
+	grouped, err := NewGroupedBars(vg.Points(8), pal, series...)
+	if err != nil {
+		log.Panic(err)
+	}
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Grouped bar chart"
+	p.Add(grouped)
+	names, thumbs := grouped.Thumbnailers()
+	for i, name := range names {
+		p.Legend.Add(name, thumbs[i])
+	}
+	p.Legend.Left = true
+	p.NominalX("One", "Two", "Three", "Four", "Five")
+	err = p.Save(250, 250, "testdata/groupedBars.png")
+	if err != nil {
+		log.Panic(err)
+	}
+
+	stacked, err := NewStackedBars(vg.Points(15), pal, series...)
+	if err != nil {
+		log.Panic(err)
+	}
+	stacked.Normalize(100)
+	p, err = plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Normalized stacked bar chart"
+	p.Add(stacked)
+	names, thumbs = stacked.Thumbnailers()
+	for i, name := range names {
+		p.Legend.Add(name, thumbs[i])
+	}
+	p.Legend.Left = true
+	p.NominalX("One", "Two", "Three", "Four", "Five")
+	err = p.Save(250, 250, "testdata/stackedBars.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestBarGroup(t *testing.T) {
+	cmpimg.CheckPlot(ExampleBarGroup, t, "groupedBars.png", "stackedBars.png")
+}
+
+func TestGroupedBars(t *testing.T) {
+	g, err := NewGroupedBars(2, nil, BarSeries{Values: Values{1, 2}}, BarSeries{Values: Values{3, 4}}, BarSeries{Values: Values{5, 6}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []vg.Length{-2, 0, 2} {
+		if got := g.Bars[i].Offset; got != want {
+			t.Errorf("unexpected offset for bar %d: got:%v want:%v", i, got, want)
+		}
+	}
+
+	_, err = NewGroupedBars(2, nil, BarSeries{Values: Values{1, 2}}, BarSeries{Values: Values{3}})
+	if err == nil {
+		t.Error("expected error for series length mismatch")
+	}
+}
+
+func TestStackedBars(t *testing.T) {
+	g, err := NewStackedBars(1, nil,
+		BarSeries{Values: Values{1, -1}},
+		BarSeries{Values: Values{-2, 3}},
+		BarSeries{Values: Values{4, -5}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := [][]float64{
+		{0, 0},
+		{0, 0},
+		{1, -1},
+	}
+	for i, b := range g.Bars {
+		for j := range b.Values {
+			if got := b.stackBase(j); got != want[i][j] {
+				t.Errorf("unexpected base for bar %d of series %d: got:%v want:%v", j, i, got, want[i][j])
+			}
+		}
+	}
+	xmin, xmax, ymin, ymax := g.DataRange()
+	if xmin != 0 || xmax != 1 || ymin != -6 || ymax != 5 {
+		t.Errorf("unexpected data range: got:[%v %v %v %v] want:[0 1 -6 5]", xmin, xmax, ymin, ymax)
+	}
+
+	names, thumbs := g.Thumbnailers()
+	for i, want := range []int{2, 1, 0} {
+		if names[i] != g.Names[want] || thumbs[i] != g.Bars[want] {
+			t.Errorf("unexpected legend entry %d: got:%q want series %d", i, names[i], want)
+		}
+	}
+
+	// A chart stacked on the group is placed by
+	// the same rule as the bars of the group.
+	top, err := NewBarChart(Values{1, -1}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	top.StackOn(g.Bars[2])
+	for j, want := range []float64{5, -6} {
+		if got := top.stackBase(j); got != want {
+			t.Errorf("unexpected base for bar %d of stacked chart: got:%v want:%v", j, got, want)
+		}
+		if got := g.Bars[2].BarHeight(j); got != want {
+			t.Errorf("unexpected height of bar %d of top series: got:%v want:%v", j, got, want)
+		}
+	}
+
+	g.Normalize(100)
+	for j, want := range []float64{100 / 7.0, -100 / 9.0} {
+		if got := g.Bars[0].Values[j]; got != want {
+			t.Errorf("unexpected normalized value %d: got:%v want:%v", j, got, want)
+		}
+	}
+}
@@ -5,6 +5,7 @@
 package plotter
 
 import (
+	"fmt"
 	"image/color"
 	"math"
 	"sort"
@@ -43,6 +44,23 @@ type Contour struct {
 	// Min and Max define the dynamic range of the
 	// heat map.
 	Min, Max float64
+
+	// LabelFormat is the fmt format used to label
+	// each contour path with its level, for example
+	// "%.1f". If LabelFormat is empty, contours are
+	// not labeled.
+	//
+	// Each label is drawn along its path, rotated to
+	// follow the path, with the line broken beneath
+	// the text. Labels that would overlap a label
+	// already drawn, or that do not fit on their path,
+	// are omitted.
+	LabelFormat string
+
+	// LabelStyle is the style of the contour labels.
+	// If the label color is nil, the color of the
+	// labeled contour is used.
+	LabelStyle draw.TextStyle
 }
 
 // NewContour creates as new contour plotter for the given data, using
@@ -78,6 +96,10 @@ func NewContour(g GridXYZ, levels []float64, p palette.Palette) *Contour {
 		levels = quantilesR7(g, defaultQuantiles)
 	}
 
+	// The default label font is only set if it
+	// is available, since NewContour cannot fail.
+	fnt, _ := vg.MakeFont(DefaultFont, DefaultFontSize)
+
 	return &Contour{
 		GridXYZ:    g,
 		Levels:     levels,
@@ -85,6 +107,11 @@ func NewContour(g GridXYZ, levels []float64, p palette.Palette) *Contour {
 		Palette:    p,
 		Min:        min,
 		Max:        max,
+		LabelStyle: draw.TextStyle{
+			Font:   fnt,
+			XAlign: draw.XCenter,
+			YAlign: draw.YCenter,
+		},
 	}
 }
 
@@ -106,12 +133,7 @@ func quantilesR7(g GridXYZ, p []float64) []float64 {
 	sort.Float64s(data)
 	v := make([]float64, len(p))
 	for j, q := range p {
-		if q == 1 {
-			v[j] = data[len(data)-1]
-		}
-		h := float64(len(data)-1) * q
-		i := int(h)
-		v[j] = data[i] + (h-math.Floor(h))*(data[i+1]-data[i])
+		v[j] = QuantileR7.Quantile(data, q)
 	}
 	return v
 }
@@ -150,15 +172,16 @@ func (h *Contour) Plot(c draw.Canvas, plt *plot.Plot) {
 		ps = 0
 	}
 
+	var labels *contourLabeler
+	if h.LabelFormat != "" && h.LabelStyle.Font.Size != 0 {
+		labels = &contourLabeler{canvas: c, style: h.LabelStyle}
+	}
+
 	for i, z := range h.Levels {
 		if math.IsNaN(z) {
 			continue
 		}
 		for _, pa := range cp[z] {
-			if isLoop(pa) {
-				pa.Close()
-			}
-
 			style := h.LineStyles[i%len(h.LineStyles)]
 			var col color.Color
 			switch {
@@ -171,13 +194,27 @@ func (h *Contour) Plot(c draw.Canvas, plt *plot.Plot) {
 			default:
 				col = pal[int((z-h.Levels[0])*ps+0.5)] // Apply palette scaling.
 			}
-			if col != nil && style.Width != 0 {
-				c.SetLineStyle(style)
-				c.SetColor(col)
-				c.Stroke(pa)
+			if col == nil || style.Width == 0 {
+				continue
+			}
+
+			parts := []vg.Path{pa}
+			if labels != nil {
+				parts = labels.place(pa, fmt.Sprintf(h.LabelFormat, z), col)
+			}
+			c.SetLineStyle(style)
+			c.SetColor(col)
+			for _, part := range parts {
+				if isLoop(part) {
+					part.Close()
+				}
+				c.Stroke(part)
 			}
 		}
 	}
+	if labels != nil {
+		labels.draw()
+	}
 }
 
 // naivePlot implements the a naive rendering approach for contours.
@@ -309,6 +346,14 @@ func contourPaths(m GridXYZ, levels []float64, trX, trY func(float64) vg.Length)
 	// furcation so it may be that the path ends at middle node
 	// of another path. This needs to be investigated.
 
+	return contourSetPaths(conts, trX, trY)
+}
+
+// contourSetPaths returns the vg.Paths described by the contours
+// in conts after excising loops from crossed paths. The trX and trY
+// function are coordinate transforms. The returned map contains slices
+// of paths keyed on the value of the contour level.
+func contourSetPaths(conts contourSet, trX, trY func(float64) vg.Length) map[float64][]vg.Path {
 	// Excise loops from crossed paths.
 	for c := range conts {
 		// Always try to do quick excision in production if possible.
@@ -7,6 +7,8 @@ package plotter
 import (
 	"flag"
 	"fmt"
+	"image/color"
+	"log"
 	"math"
 	"math/rand"
 	"reflect"
@@ -15,8 +17,11 @@ import (
 
 	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
 	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 var visualDebug = flag.Bool("visual", false, "output images for benchmarks and test data")
@@ -472,3 +477,87 @@ type testContour []*contour
 func (c testContour) Len() int           { return len(c) }
 func (c testContour) Less(i, j int) bool { return len(c[i].forward) < len(c[j].forward) }
 func (c testContour) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+
+func ExampleContour_labels() {
+	g := coneGrid(21)
+	c := NewContour(g, []float64{2, 4, 6, 8, 10, 12}, palette.Heat(6, 1))
+	c.LabelFormat = "%.0f"
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Labeled contours"
+	p.Add(c)
+	err = p.Save(300, 300, "testdata/contourLabels.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestContour_labels(t *testing.T) {
+	cmpimg.CheckPlot(ExampleContour_labels, t, "contourLabels.png")
+}
+
+func TestContourLabels(t *testing.T) {
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		t.Fatalf("failed to make font: %v", err)
+	}
+	var r recorder.Canvas
+	l := &contourLabeler{
+		canvas: draw.NewCanvas(&r, 200, 200),
+		style:  draw.TextStyle{Font: fnt, XAlign: draw.XCenter, YAlign: draw.YCenter},
+	}
+	width := l.style.Width("10") + 2*contourLabelPad
+
+	// A path drawn right to left is labeled at its middle
+	// with upright text and broken beneath the label.
+	var pa vg.Path
+	pa.Move(vg.Point{X: 190, Y: 100})
+	pa.Line(vg.Point{X: 10, Y: 100})
+	parts := l.place(pa, "10", color.Black)
+	if len(parts) != 2 || len(l.labels) != 1 {
+		t.Fatalf("unexpected placement: got %d parts and %d labels, want 2 and 1", len(parts), len(l.labels))
+	}
+	if got := l.labels[0].style.Rotation; math.Abs(got) > 1e-12 {
+		t.Errorf("unexpected label rotation: got:%v want:0", got)
+	}
+	if got := l.labels[0].at; got != (vg.Point{X: 100, Y: 100}) {
+		t.Errorf("unexpected label position: got:%v want:(100, 100)", got)
+	}
+	gap := parts[0][1].Pos.X - parts[1][0].Pos.X
+	if math.Abs(float64(gap-width)) > 1e-9 {
+		t.Errorf("unexpected line break: got:%v want:%v", gap, width)
+	}
+
+	// A second label on the same line is moved
+	// away from the first to avoid an overlap.
+	l.place(pa, "10", color.Black)
+	if len(l.labels) != 2 {
+		t.Fatalf("second label not placed")
+	}
+	if convexOverlap(l.labels[0].box[:], l.labels[1].box[:]) {
+		t.Error("labels overlap")
+	}
+
+	// A labeled loop is drawn as a single open path.
+	var loop vg.Path
+	loop.Move(vg.Point{X: 20, Y: 20})
+	loop.Line(vg.Point{X: 80, Y: 20})
+	loop.Line(vg.Point{X: 80, Y: 80})
+	loop.Line(vg.Point{X: 20, Y: 80})
+	loop.Line(vg.Point{X: 20, Y: 20})
+	parts = l.place(loop, "10", color.Black)
+	if len(parts) != 1 || isLoop(parts[0]) {
+		t.Errorf("unexpected parts for labeled loop: %v", parts)
+	}
+
+	// A path too short for its label is not labeled.
+	var short vg.Path
+	short.Move(vg.Point{X: 150, Y: 20})
+	short.Line(vg.Point{X: 150 + width, Y: 20})
+	if parts = l.place(short, "10", color.Black); len(parts) != 1 || len(l.labels) != 3 {
+		t.Errorf("unexpected label on short path")
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// contourLabelPad is the space left between a contour
+// label and the ends of the line broken beneath it.
+const contourLabelPad = vg.Length(2)
+
+// contourLabelPositions are the fractions of the length of
+// a contour path at which a label is tried, in order.
+var contourLabelPositions = []float64{0.5, 0.3, 0.7, 0.1, 0.9}
+
+// contourLabeler places labels along contour paths,
+// avoiding overlaps between labels.
+type contourLabeler struct {
+	canvas draw.Canvas
+	style  draw.TextStyle
+	labels []contourLabel
+}
+
+// contourLabel is a label placed on a contour path.
+type contourLabel struct {
+	text  string
+	at    vg.Point
+	style draw.TextStyle
+
+	// box holds the corners of the
+	// rotated label, padding included.
+	box [4]vg.Point
+}
+
+// place tries to place the text as a label on the path pa, drawn
+// with the color col. It returns the parts of pa to stroke, with
+// the line broken beneath the label if one was placed.
+func (l *contourLabeler) place(pa vg.Path, text string, col color.Color) []vg.Path {
+	pts := polyline(pa)
+	if len(pts) < 2 {
+		return []vg.Path{pa}
+	}
+	cum := cumulativeLengths(pts)
+	length := cum[len(cum)-1]
+
+	// Labels are only placed on paths
+	// at least twice as long as the label.
+	width := l.style.Width(text) + 2*contourLabelPad
+	height := l.style.Height(text)
+	if 2*width > length {
+		return []vg.Path{pa}
+	}
+
+	for _, f := range contourLabelPositions {
+		s := vg.Length(f) * length
+		s0, s1 := s-width/2, s+width/2
+		if s0 < 0 || s1 > length {
+			continue
+		}
+
+		// The text follows the chord across the
+		// label, kept upright.
+		d := pointAlong(pts, cum, s1).Sub(pointAlong(pts, cum, s0))
+		theta := math.Atan2(float64(d.Y), float64(d.X))
+		switch {
+		case theta > math.Pi/2:
+			theta -= math.Pi
+		case theta < -math.Pi/2:
+			theta += math.Pi
+		}
+
+		at := pointAlong(pts, cum, s)
+		box := labelBox(at, width, height, theta)
+		if !l.fits(box) {
+			continue
+		}
+
+		sty := l.style
+		sty.Rotation = theta
+		if sty.Color == nil {
+			sty.Color = col
+		}
+		l.labels = append(l.labels, contourLabel{text: text, at: at, style: sty, box: box})
+		return cutPolyline(pts, cum, s0, s1, isLoop(pa))
+	}
+	return []vg.Path{pa}
+}
+
+// fits returns whether the label box lies within the canvas
+// without overlapping any of the labels already placed.
+func (l *contourLabeler) fits(box [4]vg.Point) bool {
+	for _, p := range box {
+		if !l.canvas.Contains(p) {
+			return false
+		}
+	}
+	for _, lab := range l.labels {
+		if convexOverlap(box[:], lab.box[:]) {
+			return false
+		}
+	}
+	return true
+}
+
+// draw draws the placed labels.
+func (l *contourLabeler) draw() {
+	for _, lab := range l.labels {
+		l.canvas.FillText(lab.style, lab.at, lab.text)
+	}
+}
+
+// labelBox returns the corners of a rectangle of the given
+// width and height, centered on at and rotated by theta.
+func labelBox(at vg.Point, width, height vg.Length, theta float64) [4]vg.Point {
+	sin, cos := math.Sincos(theta)
+	u := vg.Point{X: vg.Length(cos), Y: vg.Length(sin)}.Scale(width / 2)
+	v := vg.Point{X: vg.Length(-sin), Y: vg.Length(cos)}.Scale(height / 2)
+	return [4]vg.Point{
+		at.Sub(u).Sub(v),
+		at.Add(u).Sub(v),
+		at.Add(u).Add(v),
+		at.Sub(u).Add(v),
+	}
+}
+
+// convexOverlap returns whether the convex polygons a and b
+// overlap, using the separating axis theorem.
+func convexOverlap(a, b []vg.Point) bool {
+	for _, poly := range [2][]vg.Point{a, b} {
+		for i, p := range poly {
+			e := poly[(i+1)%len(poly)].Sub(p)
+			norm := vg.Point{X: -e.Y, Y: e.X}
+			amin, amax := project(a, norm)
+			bmin, bmax := project(b, norm)
+			if amax <= bmin || bmax <= amin {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// project returns the range of the projections
+// of the points of poly onto the axis.
+func project(poly []vg.Point, axis vg.Point) (min, max vg.Length) {
+	min, max = vg.Length(math.Inf(1)), vg.Length(math.Inf(-1))
+	for _, p := range poly {
+		d := p.Dot(axis)
+		min = vg.Length(math.Min(float64(min), float64(d)))
+		max = vg.Length(math.Max(float64(max), float64(d)))
+	}
+	return min, max
+}
+
+// polyline returns the points of a path made
+// of move and line components.
+func polyline(pa vg.Path) []vg.Point {
+	pts := make([]vg.Point, 0, len(pa))
+	for _, comp := range pa {
+		if comp.Type == vg.MoveComp || comp.Type == vg.LineComp {
+			pts = append(pts, comp.Pos)
+		}
+	}
+	return pts
+}
+
+// cumulativeLengths returns the length of the
+// polyline pts up to each of its points.
+func cumulativeLengths(pts []vg.Point) []vg.Length {
+	cum := make([]vg.Length, len(pts))
+	for i := 1; i < len(pts); i++ {
+		d := pts[i].Sub(pts[i-1])
+		cum[i] = cum[i-1] + vg.Length(math.Hypot(float64(d.X), float64(d.Y)))
+	}
+	return cum
+}
+
+// pointAlong returns the point at the distance s along
+// the polyline pts with cumulative lengths cum.
+func pointAlong(pts []vg.Point, cum []vg.Length, s vg.Length) vg.Point {
+	i := sort.Search(len(cum), func(i int) bool { return cum[i] >= s })
+	switch {
+	case i == 0:
+		return pts[0]
+	case i == len(cum):
+		return pts[len(pts)-1]
+	}
+	seg := cum[i] - cum[i-1]
+	if seg == 0 {
+		return pts[i]
+	}
+	t := float64((s - cum[i-1]) / seg)
+	return pts[i-1].Add(pts[i].Sub(pts[i-1]).Scale(vg.Length(t)))
+}
+
+// cutPolyline returns the paths following the polyline pts
+// outside the distances s0 to s1 along it. If the polyline
+// is a loop, the parts before and after the cut are joined.
+func cutPolyline(pts []vg.Point, cum []vg.Length, s0, s1 vg.Length, loop bool) []vg.Path {
+	head := subPolyline(pts, cum, 0, s0)
+	tail := subPolyline(pts, cum, s1, cum[len(cum)-1])
+	if loop {
+		return []vg.Path{pathOf(append(tail, head[1:]...))}
+	}
+	return []vg.Path{pathOf(head), pathOf(tail)}
+}
+
+// subPolyline returns the part of the polyline pts
+// between the distances s0 and s1 along it.
+func subPolyline(pts []vg.Point, cum []vg.Length, s0, s1 vg.Length) []vg.Point {
+	sub := []vg.Point{pointAlong(pts, cum, s0)}
+	for i, p := range pts {
+		if s0 < cum[i] && cum[i] < s1 {
+			sub = append(sub, p)
+		}
+	}
+	return append(sub, pointAlong(pts, cum, s1))
+}
+
+// pathOf returns a path through the points pts.
+func pathOf(pts []vg.Point) vg.Path {
+	var pa vg.Path
+	pa.Move(pts[0])
+	for _, p := range pts[1:] {
+		pa.Line(p)
+	}
+	return pa
+}
@@ -0,0 +1,185 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// Triangulation is a Delaunay triangulation of a set of
+// scattered points. The circumcircle of each triangle
+// contains no other point of the set.
+type Triangulation struct {
+	// XYZs holds the triangulated points.
+	XYZs
+
+	// Triangles holds the indices into XYZs of the
+	// vertices of each triangle, in counter-clockwise
+	// order.
+	Triangles [][3]int
+}
+
+// NewTriangulation returns the Delaunay triangulation of the
+// X and Y locations of the points in data. The Z values are
+// carried along for use by the plotters built on the
+// triangulation. A point at the same location as an earlier
+// point is not part of any triangle. An error is returned if
+// the points do not span a region with a non-zero area.
+func NewTriangulation(data XYZer) (*Triangulation, error) {
+	xyzs, err := CopyXYZs(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(xyzs) < 3 {
+		return nil, errors.New("plotter: too few points to triangulate")
+	}
+	tris := delaunay(xyzs)
+	if len(tris) == 0 {
+		return nil, errors.New("plotter: points to triangulate are collinear")
+	}
+	return &Triangulation{XYZs: xyzs, Triangles: tris}, nil
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (t *Triangulation) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return XYRange(XYValues{t.XYZs})
+}
+
+// delaunay returns the Delaunay triangulation of the points
+// in xyzs using the Bowyer–Watson algorithm. The points are
+// inserted in order into a triangulation of a large enclosing
+// triangle, and triangles using its vertices are removed at
+// the end. The points are scaled to the unit square to keep
+// the enclosing triangle well separated from them.
+//
+// The orientation and incircle tests are exact, so cocircular
+// points, as in a regular grid, are handled consistently: a
+// triangle is only removed when the inserted point is strictly
+// inside its circumcircle, which keeps the cavity left by the
+// removed triangles star-shaped about the point.
+func delaunay(xyzs XYZs) [][3]int {
+	xmin, xmax, ymin, ymax := XYRange(XYValues{xyzs})
+	scale := math.Max(xmax-xmin, ymax-ymin)
+	if scale == 0 {
+		return nil
+	}
+
+	// The enclosing triangle's vertices have the indices
+	// n, n+1 and n+2, following the data points.
+	const big = 1e4
+	n := len(xyzs)
+	pts := make([]point, n, n+3)
+	for i, p := range xyzs {
+		pts[i] = point{X: (p.X - xmin) / scale, Y: (p.Y - ymin) / scale}
+	}
+	pts = append(pts, point{X: -big, Y: -big}, point{X: big, Y: -big}, point{X: 0, Y: big})
+
+	tris := [][3]int{{n, n + 1, n + 2}}
+	seen := make(map[point]bool)
+	for i, p := range pts[:n] {
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+
+		// Remove the triangles whose circumcircle contains
+		// p, keeping the edges of the cavity they leave in
+		// the order they are found. Edges shared by two
+		// removed triangles are interior to the cavity.
+		var edges [][2]int
+		index := make(map[[2]int]int)
+		kept := tris[:0]
+		for _, t := range tris {
+			if inCircle(pts[t[0]], pts[t[1]], pts[t[2]], p) <= 0 {
+				kept = append(kept, t)
+				continue
+			}
+			for k := range t {
+				a, b := t[k], t[(k+1)%3]
+				if j, ok := index[[2]int{b, a}]; ok {
+					edges[j][0] = -1
+					delete(index, [2]int{b, a})
+					continue
+				}
+				index[[2]int{a, b}] = len(edges)
+				edges = append(edges, [2]int{a, b})
+			}
+		}
+		tris = kept
+
+		// The cavity is star-shaped about p, so joining
+		// its counter-clockwise edges to p gives
+		// counter-clockwise triangles.
+		for _, e := range edges {
+			if e[0] < 0 {
+				continue
+			}
+			tris = append(tris, [3]int{e[0], e[1], i})
+		}
+	}
+
+	var out [][3]int
+	for _, t := range tris {
+		if t[0] >= n || t[1] >= n || t[2] >= n {
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+// orient returns twice the signed area of the triangle
+// a, b, c, positive when it is counter-clockwise.
+func orient(a, b, c point) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+}
+
+// inCircle returns a positive value if d lies inside the
+// circumcircle of the counter-clockwise triangle a, b, c,
+// a negative value if it lies outside, and zero if the four
+// points are cocircular. The sign is exact: when the floating
+// point determinant is too close to zero to be trusted, it
+// is evaluated again in rational arithmetic.
+func inCircle(a, b, c, d point) float64 {
+	adx, ady := a.X-d.X, a.Y-d.Y
+	bdx, bdy := b.X-d.X, b.Y-d.Y
+	cdx, cdy := c.X-d.X, c.Y-d.Y
+	alift := adx*adx + ady*ady
+	blift := bdx*bdx + bdy*bdy
+	clift := cdx*cdx + cdy*cdy
+	det := alift*(bdx*cdy-cdx*bdy) + blift*(cdx*ady-adx*cdy) + clift*(adx*bdy-bdx*ady)
+
+	// The error bound of the determinant is
+	// from Shewchuk's adaptive predicates.
+	const eps = 1.0 / (1 << 53)
+	permanent := alift*(math.Abs(bdx*cdy)+math.Abs(cdx*bdy)) +
+		blift*(math.Abs(cdx*ady)+math.Abs(adx*cdy)) +
+		clift*(math.Abs(adx*bdy)+math.Abs(bdx*ady))
+	if math.Abs(det) > (10+96*eps)*eps*permanent {
+		return det
+	}
+	return float64(exactInCircle(a, b, c, d))
+}
+
+// exactInCircle returns the sign of the incircle
+// determinant of inCircle, computed exactly.
+func exactInCircle(a, b, c, d point) int {
+	rat := func(v float64) *big.Rat { return new(big.Rat).SetFloat64(v) }
+	sub := func(x, y float64) *big.Rat { return new(big.Rat).Sub(rat(x), rat(y)) }
+	mul := func(x, y *big.Rat) *big.Rat { return new(big.Rat).Mul(x, y) }
+	lift := func(x, y *big.Rat) *big.Rat { return new(big.Rat).Add(mul(x, x), mul(y, y)) }
+	cross := func(x1, y1, x2, y2 *big.Rat) *big.Rat { return new(big.Rat).Sub(mul(x1, y2), mul(x2, y1)) }
+
+	adx, ady := sub(a.X, d.X), sub(a.Y, d.Y)
+	bdx, bdy := sub(b.X, d.X), sub(b.Y, d.Y)
+	cdx, cdy := sub(c.X, d.X), sub(c.Y, d.Y)
+	det := mul(lift(adx, ady), cross(bdx, bdy, cdx, cdy))
+	det.Add(det, mul(lift(bdx, bdy), cross(cdx, cdy, adx, ady)))
+	det.Add(det, mul(lift(cdx, cdy), cross(adx, ady, bdx, bdy)))
+	return det.Sign()
+}
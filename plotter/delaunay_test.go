@@ -0,0 +1,118 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTriangulation(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := XYZs{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 5}, {X: 0, Y: 5}}
+	for i := 0; i < 200; i++ {
+		data = append(data, struct{ X, Y, Z float64 }{X: 10 * rnd.Float64(), Y: 5 * rnd.Float64()})
+	}
+	// A duplicate point is not triangulated.
+	data = append(data, data[10])
+
+	tri, err := NewTriangulation(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The hull is the rectangle, with four vertices,
+	// so a triangulation of n points has 2n-6 triangles
+	// covering the rectangle.
+	n := len(data) - 1
+	if got, want := len(tri.Triangles), 2*n-6; got != want {
+		t.Errorf("unexpected number of triangles: got:%d want:%d", got, want)
+	}
+	var area float64
+	for _, v := range tri.Triangles {
+		if v[0] == len(data)-1 || v[1] == len(data)-1 || v[2] == len(data)-1 {
+			t.Errorf("duplicate point used in triangle %v", v)
+		}
+		a := orient(tri.point(v[0]), tri.point(v[1]), tri.point(v[2])) / 2
+		if a <= 0 {
+			t.Errorf("triangle %v is not counter-clockwise", v)
+		}
+		area += a
+	}
+	if math.Abs(area-50) > 1e-9 {
+		t.Errorf("unexpected triangulated area: got:%v want:50", area)
+	}
+
+	// No point lies within the circumcircle of a triangle.
+	for _, v := range tri.Triangles {
+		for i := range data {
+			if inCircle(tri.point(v[0]), tri.point(v[1]), tri.point(v[2]), tri.point(i)) > 0 {
+				t.Errorf("point %d lies within the circumcircle of triangle %v", i, v)
+			}
+		}
+	}
+
+	// The triangulation is the same each time.
+	again, err := NewTriangulation(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, v := range again.Triangles {
+		if v != tri.Triangles[i] {
+			t.Fatalf("triangulation differs between runs at triangle %d: got:%v want:%v", i, v, tri.Triangles[i])
+		}
+	}
+}
+
+func TestTriangulationGrid(t *testing.T) {
+	// The points of a regular grid are cocircular in
+	// fours, so the triangulation is not unique, but
+	// each cell must be split into two triangles.
+	for _, n := range []int{2, 3, 30, 40} {
+		var data XYZs
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				data = append(data, struct{ X, Y, Z float64 }{X: float64(i), Y: float64(j)})
+			}
+		}
+		tri, err := NewTriangulation(data)
+		if err != nil {
+			t.Fatalf("unexpected error for %d×%d grid: %v", n, n, err)
+		}
+		cells := (n - 1) * (n - 1)
+		if got, want := len(tri.Triangles), 2*cells; got != want {
+			t.Errorf("unexpected number of triangles for %d×%d grid: got:%d want:%d", n, n, got, want)
+		}
+		var area float64
+		for _, v := range tri.Triangles {
+			a := orient(tri.point(v[0]), tri.point(v[1]), tri.point(v[2])) / 2
+			if a <= 0 {
+				t.Errorf("triangle %v of %d×%d grid is not counter-clockwise", v, n, n)
+			}
+			area += a
+		}
+		if area != float64(cells) {
+			t.Errorf("unexpected triangulated area of %d×%d grid: got:%v want:%d", n, n, area, cells)
+		}
+	}
+}
+
+func TestTriangulationErrors(t *testing.T) {
+	for _, data := range []XYZs{
+		{{X: 0, Y: 0}, {X: 1, Y: 1}},
+		{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}},
+		{{X: 1, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 1}},
+	} {
+		if _, err := NewTriangulation(data); err == nil {
+			t.Errorf("expected error for %v", data)
+		}
+	}
+}
+
+// point returns the X and Y location of the ith point.
+func (t *Triangulation) point(i int) point {
+	return point{X: t.XYZs[i].X, Y: t.XYZs[i].Y}
+}
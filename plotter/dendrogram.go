@@ -0,0 +1,245 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// LeafSide specifies the side of
+// a Dendrogram its leaves are on.
+type LeafSide int
+
+const (
+	// LeavesBottom draws the leaves along the X
+	// axis, with the merges above them.
+	LeavesBottom LeafSide = iota
+
+	// LeavesTop draws the leaves along the X
+	// axis, with the merges below them at
+	// negative heights.
+	LeavesTop
+
+	// LeavesLeft draws the leaves along the Y
+	// axis, with the merges to their right.
+	LeavesLeft
+
+	// LeavesRight draws the leaves along the Y
+	// axis, with the merges to their left at
+	// negative heights.
+	LeavesRight
+)
+
+// Dendrogram implements the Plotter interface, drawing
+// the tree of a hierarchical clustering. Each merge is
+// drawn as a link joining the two merged clusters at the
+// height of the merge distance.
+type Dendrogram struct {
+	// Linkage is the drawn clustering.
+	Linkage Linkage
+
+	// Positions holds the position of each leaf
+	// along the leaf axis, in the order of the
+	// leaves.
+	Positions []float64
+
+	// Side is the side the leaves are on.
+	Side LeafSide
+
+	// LineStyle is the style of the links.
+	draw.LineStyle
+
+	// Cut and Palette specify the coloring of the
+	// clusters formed by cutting the tree at the
+	// distance Cut. If Palette is not nil, the links
+	// of each cluster of more than one leaf are drawn
+	// with the next color of the palette, in leaf
+	// order, modulo the number of colors. Links at
+	// distances not less than Cut are drawn with the
+	// LineStyle color.
+	Cut     float64
+	Palette palette.Palette
+}
+
+// NewDendrogram returns a Dendrogram of the given clustering,
+// with the leaves at positions 0, 1, …, n-1 below the tree.
+// An error is returned if the clustering is not valid.
+func NewDendrogram(l Linkage) (*Dendrogram, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+	pos := make([]float64, len(l)+1)
+	for i := range pos {
+		pos[i] = float64(i)
+	}
+	return &Dendrogram{
+		Linkage:   l,
+		Positions: pos,
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// Leaves returns the indices of the
+// clustered items in leaf order. An error
+// is returned if the Linkage is not valid.
+func (d *Dendrogram) Leaves() ([]int, error) {
+	return d.Linkage.Leaves()
+}
+
+// Plot implements the Plotter interface.
+func (d *Dendrogram) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	pt := func(pos, height float64) vg.Point {
+		switch d.Side {
+		case LeavesTop:
+			return vg.Point{X: trX(pos), Y: trY(-height)}
+		case LeavesLeft:
+			return vg.Point{X: trX(height), Y: trY(pos)}
+		case LeavesRight:
+			return vg.Point{X: trX(-height), Y: trY(pos)}
+		default:
+			return vg.Point{X: trX(pos), Y: trY(height)}
+		}
+	}
+
+	var pal []color.Color
+	if d.Palette != nil {
+		pal = d.Palette.Colors()
+	}
+	n := len(d.Linkage) + 1
+	nextColor := 0
+
+	// walk draws the cluster k and returns the
+	// position and height of its root.
+	var walk func(k int, col color.Color) (pos, height float64)
+	leaf := 0
+	walk = func(k int, col color.Color) (pos, height float64) {
+		if k < n {
+			pos = d.Positions[leaf]
+			leaf++
+			return pos, 0
+		}
+		m := d.Linkage[k-n]
+		if col == nil && len(pal) != 0 && m.Distance < d.Cut {
+			col = pal[nextColor%len(pal)]
+			nextColor++
+		}
+		pa, ha := walk(m.A, col)
+		pb, hb := walk(m.B, col)
+
+		sty := d.LineStyle
+		if col != nil {
+			sty.Color = col
+		}
+		line := []vg.Point{
+			pt(pa, ha),
+			pt(pa, m.Distance),
+			pt(pb, m.Distance),
+			pt(pb, hb),
+		}
+		c.StrokeLines(sty, c.ClipLinesXY(line)...)
+		return (pa + pb) / 2, m.Distance
+	}
+	walk(2*n-2, nil)
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (d *Dendrogram) DataRange() (xmin, xmax, ymin, ymax float64) {
+	pmin, pmax := Range(Values(d.Positions))
+	var hmax float64
+	for _, m := range d.Linkage {
+		hmax = math.Max(hmax, m.Distance)
+	}
+	switch d.Side {
+	case LeavesTop:
+		return pmin, pmax, -hmax, 0
+	case LeavesLeft:
+		return 0, hmax, pmin, pmax
+	case LeavesRight:
+		return -hmax, 0, pmin, pmax
+	default:
+		return pmin, pmax, 0, hmax
+	}
+}
+
+// ReorderHeatMap reorders the columns and rows of the heat map h
+// to the leaf order of the given dendrograms of its columns and
+// rows, and moves the leaves of the dendrograms to the X and Y
+// coordinates of the grid, so the dendrograms line up with the
+// heat map. Either dendrogram may be nil to leave the columns or
+// rows in their order. The leaves of the dendrograms index the
+// columns and rows of the grid before any reordering, so if h has
+// already been reordered, its columns or rows are put in the order
+// of the new dendrograms, keeping the order of the others, rather
+// than being permuted again. An error is returned if the number of
+// leaves of a dendrogram does not match the grid, or if its Linkage
+// is not valid.
+func ReorderHeatMap(h *HeatMap, cols, rows *Dendrogram) error {
+	nc, nr := h.GridXYZ.Dims()
+	g, ok := h.GridXYZ.(reorderedGrid)
+	if !ok {
+		g = reorderedGrid{GridXYZ: h.GridXYZ}
+	}
+	if cols != nil {
+		if len(cols.Linkage)+1 != nc {
+			return fmt.Errorf("plotter: %d dendrogram leaves for %d heat map columns", len(cols.Linkage)+1, nc)
+		}
+		leaves, err := cols.Leaves()
+		if err != nil {
+			return err
+		}
+		g.cols = leaves
+	}
+	if rows != nil {
+		if len(rows.Linkage)+1 != nr {
+			return fmt.Errorf("plotter: %d dendrogram leaves for %d heat map rows", len(rows.Linkage)+1, nr)
+		}
+		leaves, err := rows.Leaves()
+		if err != nil {
+			return err
+		}
+		g.rows = leaves
+	}
+	if cols != nil {
+		for i := range cols.Positions {
+			cols.Positions[i] = g.X(i)
+		}
+	}
+	if rows != nil {
+		for i := range rows.Positions {
+			rows.Positions[i] = g.Y(i)
+		}
+	}
+	h.GridXYZ = g
+	return nil
+}
+
+// reorderedGrid is a GridXYZ with the values of its
+// columns and rows permuted, keeping their coordinates.
+type reorderedGrid struct {
+	GridXYZ
+
+	// cols and rows hold the original index of
+	// each column and row. A nil slice leaves
+	// the order unchanged.
+	cols, rows []int
+}
+
+func (g reorderedGrid) Z(c, r int) float64 {
+	if g.cols != nil {
+		c = g.cols[c]
+	}
+	if g.rows != nil {
+		r = g.rows[r]
+	}
+	return g.GridXYZ.Z(c, r)
+}
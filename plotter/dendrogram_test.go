@@ -0,0 +1,304 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// euclidean returns the matrix of Euclidean
+// distances between the rows of m.
+func euclidean(m [][]float64) [][]float64 {
+	d := make([][]float64, len(m))
+	for i := range d {
+		d[i] = make([]float64, len(m))
+		for j := range d[i] {
+			var sum float64
+			for k := range m[i] {
+				sum += (m[i][k] - m[j][k]) * (m[i][k] - m[j][k])
+			}
+			d[i][j] = math.Sqrt(sum)
+		}
+	}
+	return d
+}
+
+// ExampleDendrogram draws the clustering of points
+// around three centers, colored by a cut of the tree.
+func ExampleDendrogram() {
+	rnd := rand.New(rand.NewSource(1))
+	var pts [][]float64
+	for _, c := range [][]float64{{0, 0}, {5, 0}, {0, 5}} {
+		for i := 0; i < 6; i++ {
+			pts = append(pts, []float64{c[0] + rnd.NormFloat64(), c[1] + rnd.NormFloat64()})
+		}
+	}
+	l, err := NewLinkage(euclidean(pts), WardLinkage)
+	if err != nil {
+		log.Panic(err)
+	}
+	d, err := NewDendrogram(l)
+	if err != nil {
+		log.Panic(err)
+	}
+	d.Cut = 6
+	d.Palette = palette.Rainbow(3, palette.Red, palette.Blue, 1, 0.8, 1)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Ward clustering"
+	p.Y.Label.Text = "Distance"
+	p.Add(d, NewGrid())
+	p.HideX()
+
+	err = p.Save(250, 200, "testdata/dendrogram.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestDendrogram(t *testing.T) {
+	cmpimg.CheckPlot(ExampleDendrogram, t, "dendrogram.png")
+}
+
+// This example shows a heat map with its rows and columns
+// reordered by the clusterings drawn beside it.
+func ExampleReorderHeatMap() {
+	rnd := rand.New(rand.NewSource(1))
+	const rows, cols = 8, 10
+	data := make([][]float64, rows)
+	for i := range data {
+		data[i] = make([]float64, cols)
+		for j := range data[i] {
+			data[i][j] = rnd.NormFloat64()
+			if i%2 == j%2 {
+				data[i][j] += 2
+			}
+		}
+	}
+	transposed := make([][]float64, cols)
+	flat := make([]float64, 0, rows*cols)
+	for j := range transposed {
+		transposed[j] = make([]float64, rows)
+		for i := range data {
+			transposed[j][i] = data[i][j]
+		}
+	}
+	for _, row := range data {
+		flat = append(flat, row...)
+	}
+
+	rl, err := NewLinkage(euclidean(data), AverageLinkage)
+	if err != nil {
+		log.Panic(err)
+	}
+	cl, err := NewLinkage(euclidean(transposed), AverageLinkage)
+	if err != nil {
+		log.Panic(err)
+	}
+	rd, err := NewDendrogram(rl)
+	if err != nil {
+		log.Panic(err)
+	}
+	rd.Side = LeavesRight
+	cd, err := NewDendrogram(cl)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	h := NewHeatMap(unitGrid{mat.NewDense(rows, cols, flat)}, palette.Heat(16, 1))
+	err = ReorderHeatMap(h, cd, rd)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	heat, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	heat.Add(h)
+	heat.X.Padding, heat.Y.Padding = 0, 0
+	heat.HideAxes()
+	xmin, xmax, ymin, ymax := h.DataRange()
+
+	top, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	top.Add(cd)
+	top.X.Min, top.X.Max = xmin, xmax
+	top.X.Padding = 0
+	top.HideAxes()
+
+	left, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	left.Add(rd)
+	left.Y.Min, left.Y.Max = ymin, ymax
+	left.Y.Padding = 0
+	left.HideAxes()
+
+	img := vgimg.New(250, 200)
+	dc := draw.New(img)
+	const side = 60
+	heatCanvas := draw.Crop(dc, side, 0, 0, -side)
+	heat.Draw(heatCanvas)
+	top.Draw(draw.Crop(dc, side, 0, 200-side, 0))
+	left.Draw(draw.Crop(dc, 0, side-250, 0, -side))
+
+	w, err := os.Create("testdata/reorderHeatMap.png")
+	if err != nil {
+		log.Panic(err)
+	}
+	png := vgimg.PngCanvas{Canvas: img}
+	if _, err = png.WriteTo(w); err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestReorderHeatMap(t *testing.T) {
+	cmpimg.CheckPlot(ExampleReorderHeatMap, t, "reorderHeatMap.png")
+}
+
+func TestDendrogramDataRange(t *testing.T) {
+	l, err := NewLinkage(lineDistances(0, 1, 3, 7), SingleLinkage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	d, err := NewDendrogram(l)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, test := range []struct {
+		side LeafSide
+		want [4]float64
+	}{
+		{side: LeavesBottom, want: [4]float64{0, 3, 0, 4}},
+		{side: LeavesTop, want: [4]float64{0, 3, -4, 0}},
+		{side: LeavesLeft, want: [4]float64{0, 4, 0, 3}},
+		{side: LeavesRight, want: [4]float64{-4, 0, 0, 3}},
+	} {
+		d.Side = test.side
+		xmin, xmax, ymin, ymax := d.DataRange()
+		if got := [4]float64{xmin, xmax, ymin, ymax}; got != test.want {
+			t.Errorf("unexpected range for side %d: got:%v want:%v", test.side, got, test.want)
+		}
+	}
+}
+
+func TestNewDendrogramErrors(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		l    Linkage
+	}{
+		{name: "empty"},
+		{name: "unknown cluster", l: Linkage{{A: 0, B: 2, Distance: 1}}},
+		{name: "merged twice", l: Linkage{{A: 0, B: 1, Distance: 1}, {A: 0, B: 2, Distance: 2}}},
+		{name: "negative distance", l: Linkage{{A: 0, B: 1, Distance: -1}}},
+	} {
+		if _, err := NewDendrogram(test.l); err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+}
+
+func TestReorderHeatMapOrder(t *testing.T) {
+	g := offsetUnitGrid{
+		XOffset: 10,
+		YOffset: 20,
+		Data: mat.NewDense(2, 4, []float64{
+			0, 1, 3, 7,
+			10, 11, 13, 17,
+		}),
+	}
+	l, err := NewLinkage(lineDistances(0, 1, 3, 7), SingleLinkage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cols, err := NewDendrogram(l)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h := NewHeatMap(g, palette.Heat(4, 1))
+	err = ReorderHeatMap(h, cols, cols)
+	if err == nil {
+		t.Error("expected error for mismatched rows")
+	}
+	err = ReorderHeatMap(h, cols, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for r, want := range [][]float64{{7, 3, 0, 1}, {17, 13, 10, 11}} {
+		for c, w := range want {
+			if got := h.GridXYZ.Z(c, r); got != w {
+				t.Errorf("unexpected value at (%d, %d): got:%v want:%v", c, r, got, w)
+			}
+		}
+	}
+	for i, want := range []float64{10, 11, 12, 13} {
+		if cols.Positions[i] != want {
+			t.Errorf("unexpected leaf position %d: got:%v want:%v", i, cols.Positions[i], want)
+		}
+	}
+
+	// Reordering again by the same dendrogram leaves the
+	// order unchanged, and a dendrogram of the rows keeps
+	// the order of the columns.
+	rl, err := NewLinkage(lineDistances(0, 10), SingleLinkage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rows, err := NewDendrogram(rl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = ReorderHeatMap(h, cols, rows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err = ReorderHeatMap(h, nil, rows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, err := rl.Leaves()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for r, rw := range want {
+		for c, w := range []float64{7, 3, 0, 1} {
+			w += float64(10 * rw)
+			if got := h.GridXYZ.Z(c, r); got != w {
+				t.Errorf("unexpected value at (%d, %d) after reordering again: got:%v want:%v", c, r, got, w)
+			}
+		}
+	}
+
+	swap, err := NewDendrogram(Linkage{
+		{A: 2, B: 3, Distance: 1},
+		{A: 0, B: 1, Distance: 1},
+		{A: 4, B: 5, Distance: 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	swap.Linkage[2].B = 1
+	if err := ReorderHeatMap(h, swap, nil); err == nil {
+		t.Error("expected error for invalid linkage")
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ECDF implements the Plotter interface, drawing the empirical
+// cumulative distribution function of a sample as a step line.
+// The function is right-continuous: at each value of the sample
+// it steps up to include the weight of that value.
+//
+// Levels of zero are not drawn, and the range of the ECDF only
+// includes the values of the sample and positive levels, so the
+// ECDF can be drawn with plot.LogScale on the X axis when the
+// sample values are positive, and on the Y axis.
+type ECDF struct {
+	// XYs holds the distinct values of the sample in
+	// increasing order, as X values, with the empirical
+	// cumulative probability at each value, the fraction
+	// of the weight of the sample less than or equal
+	// to it, as Y values.
+	XYs
+
+	// Complementary specifies that the complementary
+	// cumulative distribution function, or survival
+	// function, is drawn instead: the fraction of the
+	// weight of the sample greater than each value.
+	Complementary bool
+
+	// LineStyle is the style of the line.
+	draw.LineStyle
+
+	// surv holds the fractions of the weight
+	// of the sample greater than each value,
+	// summed from the top to keep the small
+	// fractions in the tail accurate.
+	surv []float64
+}
+
+// NewECDF returns the ECDF of the sample, with each value
+// of the sample having the same weight.
+func NewECDF(vs Valuer) (*ECDF, error) {
+	return NewWeightedECDF(vs, nil)
+}
+
+// NewWeightedECDF returns the ECDF of the sample with the
+// given weights. If weights is nil, each value has the same
+// weight. An error is returned if the lengths of the sample
+// and weights differ, a weight is negative or not finite, or
+// the weights sum to zero.
+func NewWeightedECDF(vs, weights Valuer) (*ECDF, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	w := make([]float64, len(values))
+	if weights == nil {
+		for i := range w {
+			w[i] = 1
+		}
+	} else {
+		if weights.Len() != len(values) {
+			return nil, errors.New("plotter: number of weights does not match number of values")
+		}
+		for i := range w {
+			w[i] = weights.Value(i)
+			if err := CheckFloats(w[i]); err != nil {
+				return nil, err
+			}
+			if w[i] < 0 {
+				return nil, errors.New("plotter: negative weight")
+			}
+		}
+	}
+
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Sort(ecdfSorter{order: order, values: values})
+
+	// Sum the weights of equal values, dropping
+	// values without weight.
+	var xys XYs
+	var mass []float64
+	var total float64
+	for _, i := range order {
+		if w[i] == 0 {
+			continue
+		}
+		total += w[i]
+		if n := len(xys); n > 0 && xys[n-1].X == values[i] {
+			mass[n-1] += w[i]
+			continue
+		}
+		xys = append(xys, struct{ X, Y float64 }{X: values[i]})
+		mass = append(mass, w[i])
+	}
+	if total == 0 {
+		return nil, errors.New("plotter: total weight is zero")
+	}
+
+	surv := make([]float64, len(xys))
+	var below, above float64
+	for i := range xys {
+		below += mass[i]
+		xys[i].Y = below / total
+		k := len(xys) - 1 - i
+		surv[k] = above / total
+		above += mass[k]
+	}
+	xys[len(xys)-1].Y = 1
+
+	return &ECDF{
+		XYs:       xys,
+		LineStyle: DefaultLineStyle,
+		surv:      surv,
+	}, nil
+}
+
+// ecdfSorter sorts the indices in order
+// by the values they refer to.
+type ecdfSorter struct {
+	order  []int
+	values []float64
+}
+
+func (s ecdfSorter) Len() int           { return len(s.order) }
+func (s ecdfSorter) Less(i, j int) bool { return s.values[s.order[i]] < s.values[s.order[j]] }
+func (s ecdfSorter) Swap(i, j int)      { s.order[i], s.order[j] = s.order[j], s.order[i] }
+
+// At returns the value of the drawn function at x: the
+// fraction of the weight of the sample less than or equal
+// to x, or greater than x if the ECDF is Complementary.
+func (e *ECDF) At(x float64) float64 {
+	// i is the number of distinct values less than or equal to x.
+	i := sort.Search(len(e.XYs), func(i int) bool { return e.XYs[i].X > x })
+	if e.Complementary {
+		if i == 0 {
+			return 1
+		}
+		return e.surv[i-1]
+	}
+	if i == 0 {
+		return 0
+	}
+	return e.XYs[i-1].Y
+}
+
+// level returns the level of the drawn
+// function from the ith value to the next.
+func (e *ECDF) level(i int) float64 {
+	if e.Complementary {
+		return e.surv[i]
+	}
+	return e.XYs[i].Y
+}
+
+// steps returns the vertices of the step line
+// at the positive levels of the drawn function.
+func (e *ECDF) steps() XYs {
+	var pts XYs
+	add := func(x, y float64) {
+		pts = append(pts, struct{ X, Y float64 }{X: x, Y: y})
+	}
+	n := len(e.XYs)
+	if e.Complementary {
+		// The function falls from one at the smallest
+		// value, and to zero at the largest.
+		add(e.XYs[0].X, 1)
+		for i := 0; i < n-1; i++ {
+			add(e.XYs[i].X, e.surv[i])
+			add(e.XYs[i+1].X, e.surv[i])
+		}
+		return pts
+	}
+	// The function rises from zero at the smallest
+	// value, and to one at the largest.
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			add(e.XYs[i].X, e.XYs[i-1].Y)
+		}
+		add(e.XYs[i].X, e.XYs[i].Y)
+	}
+	return pts
+}
+
+// Plot implements the plot.Plotter interface.
+func (e *ECDF) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	steps := e.steps()
+	ps := make([]vg.Point, len(steps))
+	for i, p := range steps {
+		ps[i] = vg.Point{X: trX(p.X), Y: trY(p.Y)}
+	}
+	c.StrokeLines(e.LineStyle, c.ClipLinesXY(ps)...)
+}
+
+// DataRange implements the plot.DataRanger interface,
+// returning the range of the sample values and of the
+// positive levels of the drawn function.
+func (e *ECDF) DataRange() (xmin, xmax, ymin, ymax float64) {
+	n := len(e.XYs)
+	xmin, xmax = e.XYs[0].X, e.XYs[n-1].X
+	switch {
+	case !e.Complementary:
+		ymin = e.level(0)
+	case n > 1:
+		ymin = e.level(n - 2)
+	default:
+		ymin = 1
+	}
+	return xmin, xmax, ymin, 1
+}
+
+// Thumbnail implements the plot.Thumbnailer interface.
+func (e *ECDF) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(e.LineStyle, c.Min.X, y, c.Max.X, y)
+}
@@ -0,0 +1,179 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/vgimg"
+)
+
+// ExampleECDF draws the survival function of a sample
+// of request latencies on logarithmic axes, showing
+// the fraction of requests slower than each latency.
+func ExampleECDF() {
+	rnd := rand.New(rand.NewSource(1))
+	latencies := make(Values, 1000)
+	for i := range latencies {
+		latencies[i] = math.Exp(3 + rnd.NormFloat64()/2)
+	}
+
+	e, err := NewECDF(latencies)
+	if err != nil {
+		log.Panic(err)
+	}
+	e.Complementary = true
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Latency"
+	p.X.Label.Text = "ms"
+	p.X.Scale = plot.LogScale{}
+	p.X.Tick.Marker = plot.LogTicks{}
+	p.Y.Label.Text = "P(latency > ms)"
+	p.Y.Scale = plot.LogScale{}
+	p.Y.Tick.Marker = plot.LogTicks{}
+	p.Add(e, NewGrid())
+
+	err = p.Save(300, 200, "testdata/ecdf.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestECDF(t *testing.T) {
+	cmpimg.CheckPlot(ExampleECDF, t, "ecdf.png")
+}
+
+func TestECDFValues(t *testing.T) {
+	e, err := NewWeightedECDF(Values{3, 1, 2, 3, 5}, Values{1, 2, 0, 3, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := XYs{{X: 1, Y: 0.25}, {X: 3, Y: 0.75}, {X: 5, Y: 1}}
+	if len(e.XYs) != len(want) {
+		t.Fatalf("unexpected ECDF: got:%v want:%v", e.XYs, want)
+	}
+	for i, xy := range e.XYs {
+		if xy != want[i] {
+			t.Errorf("unexpected point %d: got:%v want:%v", i, xy, want[i])
+		}
+	}
+
+	for _, test := range []struct {
+		x         float64
+		cdf, ccdf float64
+	}{
+		{x: 0, cdf: 0, ccdf: 1},
+		{x: 1, cdf: 0.25, ccdf: 0.75},
+		{x: 2, cdf: 0.25, ccdf: 0.75},
+		{x: 3, cdf: 0.75, ccdf: 0.25},
+		{x: 5, cdf: 1, ccdf: 0},
+		{x: 6, cdf: 1, ccdf: 0},
+	} {
+		e.Complementary = false
+		if got := e.At(test.x); got != test.cdf {
+			t.Errorf("unexpected CDF at %v: got:%v want:%v", test.x, got, test.cdf)
+		}
+		e.Complementary = true
+		if got := e.At(test.x); got != test.ccdf {
+			t.Errorf("unexpected CCDF at %v: got:%v want:%v", test.x, got, test.ccdf)
+		}
+	}
+
+	for _, test := range []struct {
+		complementary bool
+		steps         XYs
+		ymin          float64
+	}{
+		{
+			complementary: false,
+			steps:         XYs{{X: 1, Y: 0.25}, {X: 3, Y: 0.25}, {X: 3, Y: 0.75}, {X: 5, Y: 0.75}, {X: 5, Y: 1}},
+			ymin:          0.25,
+		},
+		{
+			complementary: true,
+			steps:         XYs{{X: 1, Y: 1}, {X: 1, Y: 0.75}, {X: 3, Y: 0.75}, {X: 3, Y: 0.25}, {X: 5, Y: 0.25}},
+			ymin:          0.25,
+		},
+	} {
+		e.Complementary = test.complementary
+		steps := e.steps()
+		if len(steps) != len(test.steps) {
+			t.Errorf("unexpected steps for complementary=%t: got:%v want:%v", test.complementary, steps, test.steps)
+			continue
+		}
+		for i, xy := range steps {
+			if xy != test.steps[i] {
+				t.Errorf("unexpected step %d for complementary=%t: got:%v want:%v", i, test.complementary, xy, test.steps[i])
+			}
+		}
+		xmin, xmax, ymin, ymax := e.DataRange()
+		if xmin != 1 || xmax != 5 || ymin != test.ymin || ymax != 1 {
+			t.Errorf("unexpected data range for complementary=%t: got:%v want:[1 5 %v 1]",
+				test.complementary, []float64{xmin, xmax, ymin, ymax}, test.ymin)
+		}
+	}
+
+	for _, weights := range []Values{{1, 2}, {1, -1, 1, 1, 1}, {0, 0, 0, 0, 0}} {
+		_, err := NewWeightedECDF(Values{3, 1, 2, 3, 5}, weights)
+		if err == nil {
+			t.Errorf("expected error for weights %v", weights)
+		}
+	}
+}
+
+func TestECDFTail(t *testing.T) {
+	// The survival function in the far tail is
+	// not lost to cancellation.
+	vs := make(Values, 1e6)
+	for i := range vs {
+		vs[i] = float64(i + 1)
+	}
+	e, err := NewECDF(vs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e.Complementary = true
+	if got, want := e.At(vs[len(vs)-2]), 1e-6; got != want {
+		t.Errorf("unexpected tail probability: got:%v want:%v", got, want)
+	}
+}
+
+func TestECDFLogScale(t *testing.T) {
+	for _, complementary := range []bool{false, true} {
+		e, err := NewECDF(Values{1, 10, 100, 1000})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		e.Complementary = complementary
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.X.Scale = plot.LogScale{}
+		p.X.Tick.Marker = plot.LogTicks{}
+		p.Y.Scale = plot.LogScale{}
+		p.Y.Tick.Marker = plot.LogTicks{}
+		p.Add(e)
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("unexpected panic drawing complementary=%t on log scales: %v", complementary, r)
+				}
+			}()
+			p.Draw(draw.New(vgimg.New(200, 200)))
+		}()
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// FilledContour implements the Plotter interface, drawing
+// a filled contour plot of the values in the GridXYZ field.
+// The region between each pair of consecutive levels is
+// filled with a single color.
+type FilledContour struct {
+	GridXYZ GridXYZ
+
+	// Levels describes the contour heights bounding
+	// the filled bands. Levels must be sorted in
+	// increasing order.
+	Levels []float64
+
+	// Palette is the color palette used to fill the
+	// bands between consecutive levels. The first band
+	// is filled with the first color of the palette and
+	// the last band with the last color. If Palette is
+	// nil or has no defined color, the bands between
+	// levels are not filled.
+	Palette palette.Palette
+
+	// Underflow and Overflow are the colors used to
+	// fill the regions below the lowest level and at
+	// or above the highest level. If either is nil, the
+	// corresponding region is not filled.
+	Underflow color.Color
+	Overflow  color.Color
+}
+
+// NewFilledContour creates a new filled contour plotter for the
+// given data, using the provided palette. If levels is nil, contours
+// are generated for the 0.01, 0.05, 0.25, 0.5, 0.75, 0.95 and 0.99
+// quantiles. The levels are copied and sorted.
+func NewFilledContour(g GridXYZ, levels []float64, p palette.Palette) *FilledContour {
+	if len(levels) == 0 {
+		levels = quantilesR7(g, defaultQuantiles)
+	} else {
+		levels = append([]float64(nil), levels...)
+	}
+	sort.Float64s(levels)
+	return &FilledContour{
+		GridXYZ: g,
+		Levels:  levels,
+		Palette: p,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+//
+// Each band is filled as a single path made up of non-overlapping
+// polygons with the same orientation, so holes and regions
+// touching the edge of the grid are rendered in the same way
+// by every vg backend, whatever its fill rule.
+func (f *FilledContour) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	colors := f.colors()
+	for i, polys := range f.bands() {
+		if colors[i] == nil || len(polys) == 0 {
+			continue
+		}
+		var pa vg.Path
+		for _, poly := range polys {
+			pts := make([]vg.Point, len(poly))
+			for j, p := range poly {
+				pts[j] = vg.Point{X: trX(p.X), Y: trY(p.Y)}
+			}
+			pts = c.ClipPolygonXY(pts)
+			if len(pts) < 3 {
+				continue
+			}
+			pa.Move(pts[0])
+			for _, p := range pts[1:] {
+				pa.Line(p)
+			}
+			pa.Close()
+		}
+		c.SetColor(colors[i])
+		c.Fill(pa)
+	}
+}
+
+// colors returns the fill color of each band, starting with
+// the underflow band and ending with the overflow band.
+func (f *FilledContour) colors() []color.Color {
+	n := len(f.Levels)
+	colors := make([]color.Color, n+1)
+	if n == 0 {
+		return colors
+	}
+	colors[0] = f.Underflow
+	colors[n] = f.Overflow
+	if f.Palette == nil || n < 2 {
+		return colors
+	}
+	pal := f.Palette.Colors()
+	if len(pal) == 0 {
+		return colors
+	}
+	if n == 2 {
+		colors[1] = pal[0]
+		return colors
+	}
+	ps := float64(len(pal)-1) / float64(n-2)
+	for i := 1; i < n; i++ {
+		colors[i] = pal[int(float64(i-1)*ps+0.5)]
+	}
+	return colors
+}
+
+// bands returns the polygons, in data coordinates, covering
+// each band of the contour plot. The first band holds the
+// values below the lowest level, the last band the values
+// at or above the highest level.
+//
+// Each grid cell is split into four triangles sharing the
+// mean of the cell's corners, as is done by conrec, so
+// the edges of the bands coincide with the lines drawn by
+// Contour. The field is linear over each triangle, so the
+// part of a triangle falling within a band is the convex
+// polygon found by clipping the triangle at the two levels
+// bounding the band. Triangles with a NaN vertex are
+// skipped.
+func (f *FilledContour) bands() [][][]point {
+	levels := f.Levels
+	bands := make([][][]point, len(levels)+1)
+	c, r := f.GridXYZ.Dims()
+	for i := 0; i < c-1; i++ {
+		for j := 0; j < r-1; j++ {
+			corners := [4]contourVertex{
+				f.vertex(i, j),
+				f.vertex(i+1, j),
+				f.vertex(i+1, j+1),
+				f.vertex(i, j+1),
+			}
+			center := contourVertex{
+				X: 0.5 * (corners[0].X + corners[2].X),
+				Y: 0.5 * (corners[0].Y + corners[2].Y),
+				Z: 0.25 * (corners[0].Z + corners[1].Z + corners[2].Z + corners[3].Z),
+			}
+			for m := range corners {
+				tri := []contourVertex{corners[m], corners[(m+1)%4], center}
+				addTriangleBands(bands, tri, levels)
+			}
+		}
+	}
+	return bands
+}
+
+// vertex returns the grid point at column c and row r.
+func (f *FilledContour) vertex(c, r int) contourVertex {
+	return contourVertex{X: f.GridXYZ.X(c), Y: f.GridXYZ.Y(r), Z: f.GridXYZ.Z(c, r)}
+}
+
+// addTriangleBands appends the parts of the counter-clockwise
+// triangle tri falling within each band bounded by the sorted
+// levels to the polygons of that band.
+func addTriangleBands(bands [][][]point, tri []contourVertex, levels []float64) {
+	zmin, zmax := math.Inf(1), math.Inf(-1)
+	for _, v := range tri {
+		if math.IsNaN(v.Z) {
+			return
+		}
+		zmin = math.Min(zmin, v.Z)
+		zmax = math.Max(zmax, v.Z)
+	}
+	if area2(tri) < 0 {
+		tri[0], tri[1] = tri[1], tri[0]
+	}
+
+	// Bands are half-open, holding values in [lo, hi).
+	first := sort.Search(len(levels), func(i int) bool { return levels[i] > zmin })
+	last := sort.Search(len(levels), func(i int) bool { return levels[i] > zmax })
+	for b := first; b <= last; b++ {
+		poly := tri
+		if b > 0 {
+			poly = clipAbove(poly, levels[b-1])
+		}
+		if b < len(levels) {
+			poly = clipBelow(poly, levels[b])
+		}
+		if len(poly) < 3 || area2(poly) == 0 {
+			continue
+		}
+		pts := make([]point, len(poly))
+		for i, v := range poly {
+			pts[i] = point{X: v.X, Y: v.Y}
+		}
+		bands[b] = append(bands[b], pts)
+	}
+}
+
+// contourVertex is a point of a linearly interpolated field.
+type contourVertex struct {
+	X, Y, Z float64
+}
+
+// clipAbove returns the part of the convex polygon p where
+// the field is at or above z.
+func clipAbove(p []contourVertex, z float64) []contourVertex {
+	return clipLevel(p, func(v float64) float64 { return v - z }, z)
+}
+
+// clipBelow returns the part of the convex polygon p where
+// the field is at or below z.
+func clipBelow(p []contourVertex, z float64) []contourVertex {
+	return clipLevel(p, func(v float64) float64 { return z - v }, z)
+}
+
+// clipLevel clips the convex polygon p to the half-plane where
+// inside(v.Z) is not negative, using the Sutherland–Hodgman
+// algorithm. Points where the polygon crosses the boundary
+// are given the height z.
+func clipLevel(p []contourVertex, inside func(float64) float64, z float64) []contourVertex {
+	var clipped []contourVertex
+	for i, cur := range p {
+		prev := p[(i+len(p)-1)%len(p)]
+		dc, dp := inside(cur.Z), inside(prev.Z)
+		if (dc >= 0) != (dp >= 0) {
+			t := dp / (dp - dc)
+			clipped = append(clipped, contourVertex{
+				X: prev.X + t*(cur.X-prev.X),
+				Y: prev.Y + t*(cur.Y-prev.Y),
+				Z: z,
+			})
+		}
+		if dc >= 0 {
+			clipped = append(clipped, cur)
+		}
+	}
+	return clipped
+}
+
+// area2 returns twice the signed area of the polygon p,
+// positive when p is counter-clockwise.
+func area2(p []contourVertex) float64 {
+	var a float64
+	for i, v := range p {
+		w := p[(i+1)%len(p)]
+		a += v.X*w.Y - w.X*v.Y
+	}
+	return a
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (f *FilledContour) DataRange() (xmin, xmax, ymin, ymax float64) {
+	c, r := f.GridXYZ.Dims()
+	return f.GridXYZ.X(0), f.GridXYZ.X(c - 1), f.GridXYZ.Y(0), f.GridXYZ.Y(r - 1)
+}
+
+// Thumbnail implements the plot.Thumbnailer interface,
+// drawing the fill colors of the bands side by side, from
+// the lowest band on the left to the highest on the right,
+// as a small color bar.
+func (f *FilledContour) Thumbnail(c *draw.Canvas) {
+	var colors []color.Color
+	for _, col := range f.colors() {
+		if col != nil {
+			colors = append(colors, col)
+		}
+	}
+	if len(colors) == 0 {
+		return
+	}
+	w := (c.Max.X - c.Min.X) / vg.Length(len(colors))
+	for i, col := range colors {
+		x0 := c.Min.X + vg.Length(i)*w
+		pts := []vg.Point{
+			{X: x0, Y: c.Min.Y},
+			{X: x0, Y: c.Max.Y},
+			{X: x0 + w, Y: c.Max.Y},
+			{X: x0 + w, Y: c.Min.Y},
+		}
+		c.FillPolygon(col, c.ClipPolygonY(pts))
+	}
+}
+
+// Thumbnailers returns a label and a thumbnailer for each
+// filled band, from the lowest to the highest, to be used
+// to add legend entries for the bands. Labels are formatted
+// with the %g verb.
+func (f *FilledContour) Thumbnailers() (legendLabels []string, thumbnailers []plot.Thumbnailer) {
+	n := len(f.Levels)
+	for i, col := range f.colors() {
+		if col == nil {
+			continue
+		}
+		var label string
+		switch i {
+		case 0:
+			label = fmt.Sprintf("< %g", f.Levels[0])
+		case n:
+			label = fmt.Sprintf("≥ %g", f.Levels[n-1])
+		default:
+			label = fmt.Sprintf("%g – %g", f.Levels[i-1], f.Levels[i])
+		}
+		legendLabels = append(legendLabels, label)
+		thumbnailers = append(thumbnailers, paletteThumbnailer{color: col})
+	}
+	return legendLabels, thumbnailers
+}
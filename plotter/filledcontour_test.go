@@ -0,0 +1,148 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette"
+)
+
+// coneGrid returns an n×n unitGrid holding the distance
+// of each grid point from the center of the grid.
+func coneGrid(n int) unitGrid {
+	m := mat.NewDense(n, n, nil)
+	c := float64(n-1) / 2
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			m.Set(i, j, math.Hypot(float64(i)-c, float64(j)-c))
+		}
+	}
+	return unitGrid{m}
+}
+
+func ExampleFilledContour() {
+	g := coneGrid(21)
+	levels := []float64{2, 4, 6, 8}
+
+	// Distances beyond the last level have no
+	// overflow color and are left unfilled.
+	f := NewFilledContour(g, levels, palette.Heat(len(levels)-1, 1))
+	f.Underflow = color.Black
+	c := NewContour(g, levels, nil)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Filled contours"
+	p.Add(f, c)
+	p.Legend.Add("distance", f)
+	labels, thumbs := f.Thumbnailers()
+	for i, l := range labels {
+		p.Legend.Add(l, thumbs[i])
+	}
+	p.Legend.Top = true
+	err = p.Save(300, 300, "testdata/filledContour.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestFilledContour(t *testing.T) {
+	cmpimg.CheckPlot(ExampleFilledContour, t, "filledContour.png")
+}
+
+func TestFilledContourBands(t *testing.T) {
+	g := coneGrid(11)
+	f := NewFilledContour(g, []float64{4, 2}, nil)
+	if f.Levels[0] != 2 || f.Levels[1] != 4 {
+		t.Fatalf("levels not sorted: %v", f.Levels)
+	}
+
+	bands := f.bands()
+	if len(bands) != 3 {
+		t.Fatalf("unexpected number of bands: got:%d want:3", len(bands))
+	}
+	areas := make([]float64, len(bands))
+	var total float64
+	for i, polys := range bands {
+		for _, poly := range polys {
+			a := polygonArea(poly)
+			if a <= 0 {
+				t.Errorf("polygon in band %d is not counter-clockwise: area=%v", i, a)
+			}
+			areas[i] += a
+		}
+		total += areas[i]
+	}
+	// The bands partition the grid.
+	if math.Abs(total-100) > 1e-9 {
+		t.Errorf("unexpected total band area: got:%v want:100", total)
+	}
+	// The middle band is an annulus around the central
+	// disc and the outer band touches the grid edges.
+	// The areas are close to those of the true circles.
+	for i, want := range []float64{4 * math.Pi, 12 * math.Pi, 100 - 16*math.Pi} {
+		if math.Abs(areas[i]-want) > 0.05*want {
+			t.Errorf("unexpected area for band %d: got:%v want:%v", i, areas[i], want)
+		}
+	}
+}
+
+func TestFilledContourNaN(t *testing.T) {
+	g := unitGrid{mat.NewDense(2, 2, []float64{0, 1, math.NaN(), 1})}
+	f := NewFilledContour(g, []float64{0.5}, nil)
+	for i, polys := range f.bands() {
+		if len(polys) != 0 {
+			t.Errorf("unexpected polygons in band %d for NaN cell: %v", i, polys)
+		}
+	}
+}
+
+func TestFilledContourColors(t *testing.T) {
+	p := palette.Heat(3, 1)
+	pal := p.Colors()
+	f := NewFilledContour(coneGrid(3), []float64{0, 1, 2, 3}, p)
+	f.Overflow = color.White
+
+	got := f.colors()
+	want := []color.Color{nil, pal[0], pal[1], pal[2], color.White}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of colors: got:%d want:%d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("unexpected color for band %d: got:%v want:%v", i, got[i], want[i])
+		}
+	}
+
+	labels, thumbs := f.Thumbnailers()
+	wantLabels := []string{"0 – 1", "1 – 2", "2 – 3", "≥ 3"}
+	if len(labels) != len(wantLabels) || len(thumbs) != len(wantLabels) {
+		t.Fatalf("unexpected number of legend entries: got:%d want:%d", len(labels), len(wantLabels))
+	}
+	for i, l := range wantLabels {
+		if labels[i] != l {
+			t.Errorf("unexpected label %d: got:%q want:%q", i, labels[i], l)
+		}
+	}
+}
+
+// polygonArea returns the signed area of the polygon p.
+func polygonArea(p []point) float64 {
+	var a float64
+	for i, v := range p {
+		w := p[(i+1)%len(p)]
+		a += v.X*w.Y - w.X*v.Y
+	}
+	return a / 2
+}
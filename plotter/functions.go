@@ -5,25 +5,68 @@
 package plotter
 
 import (
+	"math"
+
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
 )
 
+// DefaultTolerance is the default maximum distance
+// between a curve and the line drawing it for adaptive
+// sampling.
+var DefaultTolerance = vg.Points(0.5)
+
+// DefaultMaxDepth is the default number of times an
+// interval between samples may be halved by adaptive
+// sampling.
+var DefaultMaxDepth = 10
+
 // Function implements the Plotter interface,
 // drawing a line for the given function.
+//
+// The line is broken where the function
+// returns NaN or ±Inf.
 type Function struct {
 	F       func(float64) float64
 	Samples int
+
+	// Tolerance is the maximum distance between
+	// the curve and the drawn line for adaptive
+	// sampling. If Tolerance is positive, intervals
+	// between the Samples uniform samples are halved
+	// while the function at the middle of an interval
+	// is further than Tolerance from the straight
+	// line across it, or until the interval has been
+	// halved MaxDepth times. Intervals where the
+	// function changes between finite and non-finite
+	// values are also refined, to find the ends of
+	// the line, and the line is broken where the
+	// function still jumps after the last halving,
+	// such as at a pole, with the jump not shrinking
+	// as the interval is halved. If Tolerance is zero,
+	// the function is only sampled at the uniform
+	// samples. NewFunction sets it to DefaultTolerance.
+	Tolerance vg.Length
+
+	// MaxDepth is the maximum number of times
+	// an interval may be halved by adaptive
+	// sampling. If MaxDepth is zero or negative,
+	// DefaultMaxDepth is used.
+	MaxDepth int
+
 	draw.LineStyle
 }
 
 // NewFunction returns a Function that plots F using
-// the default line style with 50 samples.
+// the default line style with 50 samples, refined
+// adaptively to within DefaultTolerance.
 func NewFunction(f func(float64) float64) *Function {
 	return &Function{
 		F:         f,
 		Samples:   50,
+		Tolerance: DefaultTolerance,
+		MaxDepth:  DefaultMaxDepth,
 		LineStyle: DefaultLineStyle,
 	}
 }
@@ -32,15 +75,16 @@ func NewFunction(f func(float64) float64) *Function {
 // that connects each point in the Line.
 func (f *Function) Plot(c draw.Canvas, p *plot.Plot) {
 	trX, trY := p.Transforms(&c)
-
-	d := (p.X.Max - p.X.Min) / float64(f.Samples-1)
-	line := make([]vg.Point, f.Samples)
-	for i := range line {
-		x := p.X.Min + float64(i)*d
-		line[i].X = trX(x)
-		line[i].Y = trY(f.F(x))
+	s := curveSampler{
+		f:        func(x float64) (float64, float64) { return x, f.F(x) },
+		trX:      trX,
+		trY:      trY,
+		tol:      f.Tolerance,
+		maxDepth: f.MaxDepth,
+	}
+	for _, line := range s.sample(p.X.Min, p.X.Max, f.Samples) {
+		c.StrokeLines(f.LineStyle, c.ClipLinesXY(line)...)
 	}
-	c.StrokeLines(f.LineStyle, c.ClipLinesXY(line)...)
 }
 
 // Thumbnail draws a line in the given style down the
@@ -50,3 +94,151 @@ func (f Function) Thumbnail(c *draw.Canvas) {
 	y := c.Center().Y
 	c.StrokeLine2(f.LineStyle, c.Min.X, y, c.Max.X, y)
 }
+
+// curveSampler samples a parametric curve for drawing.
+type curveSampler struct {
+	// f returns the point of the curve at t.
+	f func(t float64) (x, y float64)
+
+	// trX and trY transform the data
+	// coordinates of the curve to the canvas.
+	trX, trY func(float64) vg.Length
+
+	// tol and maxDepth control the adaptive
+	// refinement of the samples.
+	tol      vg.Length
+	maxDepth int
+
+	lines [][]vg.Point
+	line  []vg.Point
+}
+
+// curveSample is a sample of a curve. The sample is
+// valid if it has finite coordinates.
+type curveSample struct {
+	t     float64
+	p     vg.Point
+	valid bool
+}
+
+// sample returns the lines drawing the curve for t from t0 to
+// t1, starting with n uniform samples of t. The curve is broken
+// into separate lines where it is not finite.
+func (s *curveSampler) sample(t0, t1 float64, n int) [][]vg.Point {
+	s.lines, s.line = nil, nil
+	if n < 2 {
+		n = 2
+	}
+	depth := s.maxDepth
+	if depth <= 0 {
+		depth = DefaultMaxDepth
+	}
+	d := (t1 - t0) / float64(n-1)
+	prev := s.at(t0)
+	s.add(prev)
+	for i := 1; i < n; i++ {
+		next := s.at(t0 + float64(i)*d)
+		if s.tol > 0 {
+			s.refine(prev, next, depth, -1)
+		}
+		s.add(next)
+		prev = next
+	}
+	s.end()
+	return s.lines
+}
+
+// at returns the sample of the curve at t.
+func (s *curveSampler) at(t float64) curveSample {
+	x, y := s.f(t)
+	cs := curveSample{t: t}
+	if isFinite(x) && isFinite(y) {
+		cs.p = vg.Point{X: s.trX(x), Y: s.trY(y)}
+		cs.valid = isFinite(float64(cs.p.X)) && isFinite(float64(cs.p.Y))
+	}
+	return cs
+}
+
+// refine adds the samples needed between a and b
+// to draw the curve within the tolerance. jump is
+// the distance of the middle sample of the enclosing
+// interval from the middle of its chord, or negative
+// if there is no enclosing interval.
+func (s *curveSampler) refine(a, b curveSample, depth int, jump vg.Length) {
+	if depth <= 0 || (!a.valid && !b.valid) {
+		return
+	}
+	m := s.at((a.t + b.t) / 2)
+	var mid vg.Length = -1
+	if a.valid && b.valid && m.valid {
+		if segmentDistance(m.p, a.p, b.p) <= s.tol {
+			return
+		}
+		mid = distance(m.p, a.p.Add(b.p).Scale(0.5))
+		// On a smooth curve the distance of the middle
+		// sample from the chord shrinks fourfold with each
+		// halving. If it has not even halved, and the curve
+		// still turns back between a and b after the last
+		// halving, treat it as a jump, such as at a pole.
+		if depth == 1 && jump >= 0 && mid > jump/2 && !between(m.p, a.p, b.p) {
+			s.end()
+			return
+		}
+	}
+	s.refine(a, m, depth-1, mid)
+	s.add(m)
+	s.refine(m, b, depth-1, mid)
+}
+
+// add adds the sample to the current line,
+// ending the line if the sample is not valid.
+func (s *curveSampler) add(cs curveSample) {
+	if !cs.valid {
+		s.end()
+		return
+	}
+	s.line = append(s.line, cs.p)
+}
+
+// end ends the current line.
+func (s *curveSampler) end() {
+	if len(s.line) > 1 {
+		s.lines = append(s.lines, s.line)
+	}
+	s.line = nil
+}
+
+// segmentDistance returns the distance
+// between p and the line segment ab.
+func segmentDistance(p, a, b vg.Point) vg.Length {
+	ab := b.Sub(a)
+	ap := p.Sub(a)
+	l2 := ab.Dot(ab)
+	if l2 > 0 {
+		f := ap.Dot(ab) / l2
+		switch {
+		case f > 1:
+			ap = p.Sub(b)
+		case f > 0:
+			ap = ap.Sub(ab.Scale(f))
+		}
+	}
+	return vg.Length(math.Hypot(float64(ap.X), float64(ap.Y)))
+}
+
+// distance returns the distance between p and q.
+func distance(p, q vg.Point) vg.Length {
+	d := p.Sub(q)
+	return vg.Length(math.Hypot(float64(d.X), float64(d.Y)))
+}
+
+// between returns whether p is within the
+// rectangle with the opposite corners a and b.
+func between(p, a, b vg.Point) bool {
+	return (a.X <= p.X) == (p.X <= b.X) && (a.Y <= p.Y) == (p.Y <= b.Y)
+}
+
+// isFinite returns whether v is neither NaN nor infinite.
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
@@ -59,3 +59,162 @@ func ExampleFunction() {
 func TestFunction(t *testing.T) {
 	cmpimg.CheckPlot(ExampleFunction, t, "functions.png")
 }
+
+// This example shows adaptive sampling of a function with
+// sharp features and a function with discontinuities.
+func ExampleFunction_adaptive() {
+	sinc := NewFunction(func(x float64) float64 {
+		if x == 0 {
+			return 1
+		}
+		return math.Sin(20*x) / (20 * x)
+	})
+	sinc.Samples = 20
+	sinc.Tolerance = vg.Points(0.25)
+
+	tan := NewFunction(math.Tan)
+	tan.Tolerance = vg.Points(0.25)
+	tan.Color = color.RGBA{R: 255, A: 255}
+
+	sqrt := NewFunction(math.Sqrt)
+	sqrt.Color = color.RGBA{B: 255, A: 255}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Adaptive sampling"
+	p.Add(NewGrid(), sinc, tan, sqrt)
+	p.Legend.Add("sin(20x)/20x", sinc)
+	p.Legend.Add("tan(x)", tan)
+	p.Legend.Add("√x", sqrt)
+
+	p.X.Min = -3
+	p.X.Max = 3
+	p.Y.Min = -2
+	p.Y.Max = 2
+
+	err = p.Save(200, 200, "testdata/functionAdaptive.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestFunction_adaptive(t *testing.T) {
+	cmpimg.CheckPlot(ExampleFunction_adaptive, t, "functionAdaptive.png")
+}
+
+func TestCurveSampler(t *testing.T) {
+	ident := func(v float64) vg.Length { return vg.Length(v) }
+	for _, test := range []struct {
+		name  string
+		f     func(float64) float64
+		tol   vg.Length
+		lines int
+		check func(lines [][]vg.Point) bool
+	}{
+		{
+			name:  "line",
+			f:     func(x float64) float64 { return 2 * x },
+			tol:   0.01,
+			lines: 1,
+			check: func(lines [][]vg.Point) bool { return len(lines[0]) == 4 },
+		},
+		{
+			name:  "sqrt",
+			f:     math.Sqrt,
+			tol:   0.01,
+			lines: 1,
+			check: func(lines [][]vg.Point) bool {
+				// The start of the line is found by bisection.
+				return lines[0][0].X >= 0 && lines[0][0].X < 0.01
+			},
+		},
+		{
+			name:  "pole",
+			f:     func(x float64) float64 { return 1 / x },
+			tol:   0.01,
+			lines: 2,
+		},
+		{
+			name:  "nan",
+			f:     func(x float64) float64 { return math.NaN() },
+			lines: 0,
+		},
+		{
+			name: "parabola",
+			f:    func(x float64) float64 { return x * x },
+			tol:  0.01,
+			check: func(lines [][]vg.Point) bool {
+				for _, l := range lines {
+					for i := 1; i < len(l); i++ {
+						mx := (l[i-1].X + l[i].X) / 2
+						my := (l[i-1].Y + l[i].Y) / 2
+						if my-mx*mx > 0.01 {
+							return false
+						}
+					}
+				}
+				return len(lines[0]) > 5
+			},
+			lines: 1,
+		},
+	} {
+		s := curveSampler{
+			f:        func(x float64) (float64, float64) { return x, test.f(x) },
+			trX:      ident,
+			trY:      ident,
+			tol:      test.tol,
+			maxDepth: DefaultMaxDepth,
+		}
+		lines := s.sample(-1, 1, 4)
+		if len(lines) != test.lines {
+			t.Errorf("unexpected number of lines for %s: got:%d want:%d", test.name, len(lines), test.lines)
+			continue
+		}
+		if test.check != nil && !test.check(lines) {
+			t.Errorf("unexpected lines for %s: %v", test.name, lines)
+		}
+	}
+}
+
+func TestCurveSamplerPeaks(t *testing.T) {
+	// Smooth peaks and troughs are not taken to be
+	// poles, however few times the intervals are halved.
+	ident := func(v float64) vg.Length { return vg.Length(v) }
+	for _, depth := range []int{1, 2, DefaultMaxDepth} {
+		s := curveSampler{
+			f:        func(x float64) (float64, float64) { return x, math.Sin(x) },
+			trX:      ident,
+			trY:      ident,
+			tol:      0.5,
+			maxDepth: depth,
+		}
+		if lines := s.sample(0, 4*math.Pi, 5); len(lines) != 1 {
+			t.Errorf("unexpected number of lines for sin with depth %d: got:%d want:1", depth, len(lines))
+		}
+	}
+
+	// A zero maximum depth refines to DefaultMaxDepth.
+	s0 := curveSampler{
+		f:   func(x float64) (float64, float64) { return x, math.Sin(x) },
+		trX: ident,
+		trY: ident,
+		tol: 0.01,
+	}
+	if lines := s0.sample(0, 4*math.Pi, 5); len(lines) != 1 || len(lines[0]) <= 5 {
+		t.Errorf("unexpected samples of sin with zero maximum depth: %v", lines)
+	}
+
+	// The poles of tan break the line.
+	s := curveSampler{
+		f:        func(x float64) (float64, float64) { return x, math.Tan(x) },
+		trX:      ident,
+		trY:      ident,
+		tol:      0.01,
+		maxDepth: DefaultMaxDepth,
+	}
+	if lines := s.sample(-3, 3, 7); len(lines) != 3 {
+		t.Errorf("unexpected number of lines for tan: got:%d want:3", len(lines))
+	}
+}
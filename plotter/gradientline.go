@@ -0,0 +1,193 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// GradientLine implements the Plotter interface, drawing a
+// line through a sequence of points, with each segment colored
+// through a ColorMap by the Z values of its end points, such as
+// the time or speed along a trajectory.
+//
+// Each segment is filled as a quadrilateral that shares its end
+// edges with the neighboring segments, mitered at the joins, so
+// that there are no gaps or overlapping strokes between segments
+// of different colors.
+type GradientLine struct {
+	// XYZs is a copy of the points of the line,
+	// with the values mapped to colors as Z values.
+	XYZs
+
+	// ColorMap maps the Z values to colors. Segments
+	// with Z values outside the range of the ColorMap
+	// are given the color of the nearest bound.
+	ColorMap palette.ColorMap
+
+	// Width is the width of the line.
+	Width vg.Length
+
+	// MiterLimit is the limit of the ratio of the
+	// length of a miter to half the line width. Joins
+	// with longer miters, at sharp turns of the line,
+	// are cut off at the limit.
+	MiterLimit float64
+}
+
+// NewGradientLine returns a GradientLine of the points,
+// colored through the ColorMap.
+//
+// NewGradientLine sets the range of the ColorMap, which
+// is not copied, to the range of the Z values, replacing
+// any range set by the caller and changing the colors of
+// other plotters sharing the ColorMap. To color by another
+// range, set the range of the ColorMap after calling
+// NewGradientLine.
+func NewGradientLine(xyzs XYZer, cm palette.ColorMap) (*GradientLine, error) {
+	if cm == nil {
+		return nil, errors.New("plotter: nil ColorMap")
+	}
+	data, err := CopyXYZs(xyzs)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	minz, maxz := data[0].Z, data[0].Z
+	for _, d := range data {
+		minz = math.Min(minz, d.Z)
+		maxz = math.Max(maxz, d.Z)
+	}
+	if minz == maxz {
+		minz, maxz = minz-0.5, maxz+0.5
+	}
+	cm.SetMin(minz)
+	cm.SetMax(maxz)
+	return &GradientLine{
+		XYZs:       data,
+		ColorMap:   cm,
+		Width:      DefaultLineStyle.Width,
+		MiterLimit: 4,
+	}, nil
+}
+
+// color returns the color of the value v, or nil
+// if the ColorMap has no color for it.
+func (l *GradientLine) color(v float64) color.Color {
+	return clampedColor(l.ColorMap, v)
+}
+
+// Plot implements the plot.Plotter interface.
+func (l *GradientLine) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	// Drop the points that coincide with the previous
+	// point on the canvas, since the segments between
+	// them have no direction.
+	var ps []vg.Point
+	var zs []float64
+	for _, d := range l.XYZs {
+		p := vg.Point{X: trX(d.X), Y: trY(d.Y)}
+		if n := len(ps); n > 0 && ps[n-1] == p {
+			zs[n-1] = d.Z
+			continue
+		}
+		ps = append(ps, p)
+		zs = append(zs, d.Z)
+	}
+
+	for i, q := range l.quads(ps) {
+		col := l.color((zs[i] + zs[i+1]) / 2)
+		if col == nil {
+			continue
+		}
+		c.FillPolygon(col, c.ClipPolygonXY(q))
+	}
+}
+
+// quads returns the quadrilaterals filled for the
+// segments of the line through the points.
+func (l *GradientLine) quads(ps []vg.Point) [][]vg.Point {
+	if len(ps) < 2 {
+		return nil
+	}
+	offs := make([]vg.Point, len(ps))
+	for i := range ps {
+		offs[i] = l.offset(ps, i)
+	}
+	quads := make([][]vg.Point, len(ps)-1)
+	for i := range quads {
+		quads[i] = []vg.Point{
+			ps[i].Add(offs[i]),
+			ps[i+1].Add(offs[i+1]),
+			ps[i+1].Sub(offs[i+1]),
+			ps[i].Sub(offs[i]),
+		}
+	}
+	return quads
+}
+
+// offset returns the offset from the ith point to the
+// left edge of the line, along the normal of the line
+// at its ends and along the miter at its joins.
+func (l *GradientLine) offset(ps []vg.Point, i int) vg.Point {
+	half := float64(l.Width) / 2
+	switch i {
+	case 0:
+		x, y := unitNormal(ps[0], ps[1])
+		return vg.Point{X: vg.Length(x * half), Y: vg.Length(y * half)}
+	case len(ps) - 1:
+		x, y := unitNormal(ps[i-1], ps[i])
+		return vg.Point{X: vg.Length(x * half), Y: vg.Length(y * half)}
+	}
+	x1, y1 := unitNormal(ps[i-1], ps[i])
+	x2, y2 := unitNormal(ps[i], ps[i+1])
+	mx, my := x1+x2, y1+y2
+	norm := math.Hypot(mx, my)
+	if norm < 1e-9 {
+		// The line turns back on itself.
+		return vg.Point{X: vg.Length(x1 * half), Y: vg.Length(y1 * half)}
+	}
+	mx, my = mx/norm, my/norm
+	// The miter reaches the edges of both segments
+	// when its projection on their normals is half
+	// the width of the line.
+	length := half / (mx*x1 + my*y1)
+	if limit := l.MiterLimit * half; length > limit {
+		length = limit
+	}
+	return vg.Point{X: vg.Length(mx * length), Y: vg.Length(my * length)}
+}
+
+// unitNormal returns the unit vector to the left
+// of the direction from a to b.
+func unitNormal(a, b vg.Point) (x, y float64) {
+	dx, dy := float64(b.X-a.X), float64(b.Y-a.Y)
+	d := math.Hypot(dx, dy)
+	return -dy / d, dx / d
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (l *GradientLine) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return XYRange(XYValues{l.XYZs})
+}
+
+// Thumbnail implements the plot.Thumbnailer interface,
+// drawing a horizontal line of the width of the line,
+// colored from the minimum of the ColorMap on the left
+// to its maximum on the right.
+func (l *GradientLine) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	fillColorStrip(c, l.ColorMap, y-l.Width/2, y+l.Width/2)
+}
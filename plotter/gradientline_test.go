@@ -0,0 +1,144 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// ExampleGradientLine draws the trajectory of a projectile
+// with drag, colored by its speed along the path.
+func ExampleGradientLine() {
+	const (
+		dt   = 0.02
+		g    = 9.81
+		drag = 0.05
+	)
+	var path XYZs
+	x, y, vx, vy := 0.0, 0.0, 20.0, 20.0
+	for y >= 0 {
+		v := math.Hypot(vx, vy)
+		path = append(path, struct{ X, Y, Z float64 }{X: x, Y: y, Z: v})
+		vx -= drag * v * vx * dt
+		vy -= (g + drag*v*vy) * dt
+		x += vx * dt
+		y += vy * dt
+	}
+
+	l, err := NewGradientLine(path, moreland.ExtendedBlackBody())
+	if err != nil {
+		log.Panic(err)
+	}
+	l.Width = vg.Points(3)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Projectile"
+	p.X.Label.Text = "Distance (m)"
+	p.Y.Label.Text = "Height (m)"
+	p.Add(l)
+	p.Legend.Add("speed", l)
+	p.Legend.Top = true
+
+	err = p.Save(300, 200, "testdata/gradientLine.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestGradientLine(t *testing.T) {
+	cmpimg.CheckPlot(ExampleGradientLine, t, "gradientLine.png")
+}
+
+func TestGradientLineJoins(t *testing.T) {
+	l := &GradientLine{Width: 2, MiterLimit: 4}
+	quads := l.quads([]vg.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}})
+	want := [][]vg.Point{
+		{{X: 0, Y: 1}, {X: 9, Y: 1}, {X: 11, Y: -1}, {X: 0, Y: -1}},
+		{{X: 9, Y: 1}, {X: 9, Y: 10}, {X: 11, Y: 10}, {X: 11, Y: -1}},
+	}
+	if len(quads) != len(want) {
+		t.Fatalf("unexpected number of segments: got:%d want:%d", len(quads), len(want))
+	}
+	for i, q := range quads {
+		for j, p := range q {
+			if !samePoint(p, want[i][j]) {
+				t.Errorf("unexpected vertex %d of segment %d: got:%v want:%v", j, i, p, want[i][j])
+			}
+		}
+	}
+
+	// A sharp turn has its miter cut off at the limit,
+	// and the joined segments still share their edge.
+	l.MiterLimit = 2
+	quads = l.quads([]vg.Point{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 1}})
+	off := quads[0][1].Sub(vg.Point{X: 10, Y: 0})
+	if got := math.Hypot(float64(off.X), float64(off.Y)); math.Abs(got-2) > 1e-9 {
+		t.Errorf("unexpected miter length: got:%v want:2", got)
+	}
+	if !samePoint(quads[0][1], quads[1][0]) || !samePoint(quads[0][2], quads[1][3]) {
+		t.Errorf("segments do not share their join: got:%v and %v", quads[0], quads[1])
+	}
+}
+
+func TestGradientLinePlot(t *testing.T) {
+	cm := moreland.SmoothBlueRed()
+	l, err := NewGradientLine(XYZs{{X: 0, Y: 0, Z: 1}, {X: 1, Y: 0, Z: 2}, {X: 1, Y: 0, Z: 3}, {X: 2, Y: 1, Z: 5}}, cm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cm.Min() != 1 || cm.Max() != 5 {
+		t.Errorf("unexpected ColorMap range: got:[%v, %v] want:[1, 5]", cm.Min(), cm.Max())
+	}
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(l)
+
+	var rec recorder.Canvas
+	l.Plot(draw.NewCanvas(&rec, 100, 100), p)
+	var colors []*recorder.SetColor
+	var fills int
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			colors = append(colors, a)
+		case *recorder.Fill:
+			fills++
+		}
+	}
+	// The repeated point is dropped, and the segments
+	// are colored by the mean of the Z values at their
+	// ends, taking the last Z value of the repeated point.
+	if fills != 2 || len(colors) != 2 {
+		t.Fatalf("unexpected number of segments: got:%d fills and %d colors want:2", fills, len(colors))
+	}
+	for i, z := range []float64{2, 4} {
+		want, err := cm.At(z)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !sameColor(colors[i].Color, want) {
+			t.Errorf("unexpected color of segment %d: got:%v want:%v", i, colors[i].Color, want)
+		}
+	}
+
+	_, err = NewGradientLine(XYZs{}, cm)
+	if err == nil {
+		t.Errorf("expected error for no data")
+	}
+}
@@ -5,6 +5,7 @@
 package plotter
 
 import (
+	"fmt"
 	"image/color"
 	"math"
 
@@ -57,6 +58,32 @@ type HeatMap struct {
 	// Min and Max define the dynamic range of the
 	// heat map.
 	Min, Max float64
+
+	// XEdges and YEdges, if not nil, hold the coordinates
+	// of the edges of the cells along the X and Y axes,
+	// from the first edge of the first column or row to
+	// the last edge of the last, so they are one longer
+	// than the number of columns or rows. They allow
+	// irregular and logarithmically spaced grids to be
+	// drawn with the correct cell boundaries. If they
+	// are nil, the edges are placed midway between
+	// neighboring coordinates of the grid. DataRange
+	// and Plot panic if the number of edges does not
+	// match the grid.
+	XEdges, YEdges []float64
+
+	// LabelFormat is the fmt format used to write
+	// the value of each cell in the cell, for example
+	// "%.2f". If LabelFormat is empty, the cells are
+	// not labeled. Labels that do not fit in their
+	// cell, and labels of NaN values, are omitted.
+	LabelFormat string
+
+	// LabelStyle is the style of the cell labels.
+	// If the label color is nil, each label is drawn
+	// in black or white, whichever contrasts more
+	// with the color of its cell.
+	LabelStyle draw.TextStyle
 }
 
 // NewHeatMap creates as new heat map plotter for the given data,
@@ -88,11 +115,20 @@ func NewHeatMap(g GridXYZ, p palette.Palette) *HeatMap {
 		}
 	}
 
+	// The default label font is only set if it
+	// is available, since NewHeatMap cannot fail.
+	fnt, _ := vg.MakeFont(DefaultFont, DefaultFontSize)
+
 	return &HeatMap{
 		GridXYZ: g,
 		Palette: p,
 		Min:     min,
 		Max:     max,
+		LabelStyle: draw.TextStyle{
+			Font:   fnt,
+			XAlign: draw.XCenter,
+			YAlign: draw.YCenter,
+		},
 	}
 }
 
@@ -110,38 +146,18 @@ func (h *HeatMap) Plot(c draw.Canvas, plt *plot.Plot) {
 
 	trX, trY := plt.Transforms(&c)
 
+	cols, rows := h.dims()
+	labels := h.LabelFormat != "" && h.LabelStyle.Font.Size != 0
+
 	var pa vg.Path
-	cols, rows := h.GridXYZ.Dims()
 	for i := 0; i < cols; i++ {
-		var right, left float64
-		switch i {
-		case 0:
-			right = (h.GridXYZ.X(1) - h.GridXYZ.X(0)) / 2
-			left = -right
-		case cols - 1:
-			right = (h.GridXYZ.X(cols-1) - h.GridXYZ.X(cols-2)) / 2
-			left = -right
-		default:
-			right = (h.GridXYZ.X(i+1) - h.GridXYZ.X(i)) / 2
-			left = -(h.GridXYZ.X(i) - h.GridXYZ.X(i-1)) / 2
-		}
+		left, right := cellEdges(h.XEdges, cols, h.GridXYZ.X, i)
 
 		for j := 0; j < rows; j++ {
-			var up, down float64
-			switch j {
-			case 0:
-				up = (h.GridXYZ.Y(1) - h.GridXYZ.Y(0)) / 2
-				down = -up
-			case rows - 1:
-				up = (h.GridXYZ.Y(rows-1) - h.GridXYZ.Y(rows-2)) / 2
-				down = -up
-			default:
-				up = (h.GridXYZ.Y(j+1) - h.GridXYZ.Y(j)) / 2
-				down = -(h.GridXYZ.Y(j) - h.GridXYZ.Y(j-1)) / 2
-			}
+			down, up := cellEdges(h.YEdges, rows, h.GridXYZ.Y, j)
 
-			x, y := trX(h.GridXYZ.X(i)+left), trY(h.GridXYZ.Y(j)+down)
-			dx, dy := trX(h.GridXYZ.X(i)+right), trY(h.GridXYZ.Y(j)+up)
+			x, y := trX(left), trY(down)
+			dx, dy := trX(right), trY(up)
 
 			if !c.Contains(vg.Point{X: x, Y: y}) || !c.Contains(vg.Point{X: dx, Y: dy}) {
 				continue
@@ -155,7 +171,8 @@ func (h *HeatMap) Plot(c draw.Canvas, plt *plot.Plot) {
 			pa.Close()
 
 			var col color.Color
-			switch v := h.GridXYZ.Z(i, j); {
+			v := h.GridXYZ.Z(i, j)
+			switch {
 			case v < h.Min:
 				col = h.Underflow
 			case v > h.Max:
@@ -169,31 +186,104 @@ func (h *HeatMap) Plot(c draw.Canvas, plt *plot.Plot) {
 				c.SetColor(col)
 				c.Fill(pa)
 			}
+
+			if !labels || math.IsNaN(v) {
+				continue
+			}
+			txt := fmt.Sprintf(h.LabelFormat, v)
+			sty := h.LabelStyle
+			if sty.Color == nil {
+				sty.Color = contrastColor(col)
+			}
+			if sty.Width(txt) > vg.Length(math.Abs(float64(dx-x))) || sty.Height(txt) > vg.Length(math.Abs(float64(dy-y))) {
+				continue
+			}
+			c.FillText(sty, vg.Point{X: (x + dx) / 2, Y: (y + dy) / 2}, txt)
 		}
 	}
 }
 
-// DataRange implements the DataRange method
-// of the plot.DataRanger interface.
-func (h *HeatMap) DataRange() (xmin, xmax, ymin, ymax float64) {
-	c, r := h.GridXYZ.Dims()
-	switch c {
-	case 1: // Make a unit length when there is no neighbour.
-		xmax = 0.5
-		xmin = -0.5
-	default:
-		xmax = h.GridXYZ.X(c-1) + (h.GridXYZ.X(c-1)-h.GridXYZ.X(c-2))/2
-		xmin = h.GridXYZ.X(0) - (h.GridXYZ.X(1)-h.GridXYZ.X(0))/2
+// dims returns the dimensions of the grid, as GridXYZ.Dims,
+// after checking that XEdges and YEdges, if they are not
+// nil, hold one edge more than the number of columns and
+// rows. dims panics if the number of edges does not match.
+func (h *HeatMap) dims() (cols, rows int) {
+	cols, rows = h.GridXYZ.Dims()
+	if h.XEdges != nil && len(h.XEdges) != cols+1 {
+		panic("heatmap: number of X edges does not match number of columns")
+	}
+	if h.YEdges != nil && len(h.YEdges) != rows+1 {
+		panic("heatmap: number of Y edges does not match number of rows")
+	}
+	return cols, rows
+}
+
+// cellEdges returns the coordinates of the edges of the
+// ith of n cells along an axis, taken from edges if it
+// is not nil, and otherwise placed midway between the
+// coordinate of the cell, given by at, and those of its
+// neighbors. The outer edges of the outer cells are
+// placed symmetrically about their coordinates, half
+// a unit away when there is a single cell.
+func cellEdges(edges []float64, n int, at func(int) float64, i int) (lo, hi float64) {
+	if edges != nil {
+		return edges[i], edges[i+1]
 	}
-	switch r {
-	case 1: // Make a unit length when there is no neighbour.
-		ymax = 0.5
-		ymin = -0.5
+	v := at(i)
+	var d float64
+	switch {
+	case n == 1:
+		d = 0.5
+	case i == 0:
+		d = (at(1) - v) / 2
+	case i == n-1:
+		d = (v - at(n-2)) / 2
 	default:
-		ymax = h.GridXYZ.Y(r-1) + (h.GridXYZ.Y(r-1)-h.GridXYZ.Y(r-2))/2
-		ymin = h.GridXYZ.Y(0) - (h.GridXYZ.Y(1)-h.GridXYZ.Y(0))/2
+		return v - (v-at(i-1))/2, v + (at(i+1)-v)/2
+	}
+	return v - d, v + d
+}
+
+// contrastColor returns black or white, whichever has
+// the greater contrast ratio with the color c, by the
+// relative luminance of c. Black is returned for a nil
+// or transparent color, which leaves the background.
+func contrastColor(c color.Color) color.Color {
+	if c == nil {
+		return color.Black
+	}
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return color.Black
 	}
-	return xmin, xmax, ymin, ymax
+	// linear returns the linear intensity of the
+	// premultiplied sRGB component v.
+	linear := func(v uint32) float64 {
+		s := float64(v) / float64(a)
+		if s <= 0.04045 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	l := 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+
+	// The contrast ratios with black and white are
+	// (l+0.05)/0.05 and 1.05/(l+0.05).
+	if (l+0.05)*(l+0.05) > 0.05*1.05 {
+		return color.Black
+	}
+	return color.White
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (h *HeatMap) DataRange() (xmin, xmax, ymin, ymax float64) {
+	c, r := h.dims()
+	xlo, _ := cellEdges(h.XEdges, c, h.GridXYZ.X, 0)
+	_, xhi := cellEdges(h.XEdges, c, h.GridXYZ.X, c-1)
+	ylo, _ := cellEdges(h.YEdges, r, h.GridXYZ.Y, 0)
+	_, yhi := cellEdges(h.YEdges, r, h.GridXYZ.Y, r-1)
+	return math.Min(xlo, xhi), math.Max(xlo, xhi), math.Min(ylo, yhi), math.Max(ylo, yhi)
 }
 
 // GlyphBoxes implements the GlyphBoxes method
@@ -6,6 +6,7 @@ package plotter
 
 import (
 	"fmt"
+	"image/color"
 	"log"
 	"os"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/vg"
 	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 	"gonum.org/v1/plot/vg/vgimg"
 )
 
@@ -106,3 +108,167 @@ func ExampleHeatMap() {
 func TestHeatMap(t *testing.T) {
 	cmpimg.CheckPlot(ExampleHeatMap, t, "heatMap.png")
 }
+
+// ExampleHeatMap_labels draws a confusion matrix of a
+// classifier, with the count of each pair of true and
+// predicted classes written in its cell.
+func ExampleHeatMap_labels() {
+	counts := offsetUnitGrid{
+		Data: mat.NewDense(3, 3, []float64{
+			41, 3, 1,
+			5, 36, 4,
+			0, 7, 33,
+		})}
+	h := NewHeatMap(counts, palette.Heat(16, 1))
+	h.LabelFormat = "%.0f"
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Confusion matrix"
+	p.X.Label.Text = "Predicted class"
+	p.Y.Label.Text = "True class"
+	p.Add(h)
+
+	err = p.Save(200, 200, "testdata/heatMapLabels.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestHeatMap_labels(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHeatMap_labels, t, "heatMapLabels.png")
+}
+
+// colorList is a palette of the listed colors.
+type colorList []color.Color
+
+func (p colorList) Colors() []color.Color { return p }
+
+func TestHeatMapEdges(t *testing.T) {
+	m := offsetUnitGrid{Data: mat.NewDense(1, 3, []float64{1, 2, 3})}
+	h := NewHeatMap(m, colorList{color.Black, color.White})
+	h.XEdges = []float64{0, 1, 3, 6}
+	h.YEdges = []float64{-1, 1}
+	xmin, xmax, ymin, ymax := h.DataRange()
+	if xmin != 0 || xmax != 6 || ymin != -1 || ymax != 1 {
+		t.Errorf("unexpected data range: got:%v want:[0 6 -1 1]", []float64{xmin, xmax, ymin, ymax})
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(h)
+	var rec recorder.Canvas
+	h.Plot(draw.NewCanvas(&rec, 60, 20), p)
+	var fills []vg.Path
+	for _, a := range rec.Actions {
+		if f, ok := a.(*recorder.Fill); ok {
+			fills = append(fills, f.Path)
+		}
+	}
+	want := [][2]vg.Length{{0, 10}, {10, 30}, {30, 60}}
+	if len(fills) != len(want) {
+		t.Fatalf("unexpected number of cells: got:%d want:%d", len(fills), len(want))
+	}
+	for i, path := range fills {
+		if got := [2]vg.Length{path[0].Pos.X, path[1].Pos.X}; got != want[i] {
+			t.Errorf("unexpected X extent of cell %d: got:%v want:%v", i, got, want[i])
+		}
+		if path[0].Pos.Y != 0 || path[2].Pos.Y != 20 {
+			t.Errorf("unexpected Y extent of cell %d: got:[%v %v] want:[0 20]", i, path[0].Pos.Y, path[2].Pos.Y)
+		}
+	}
+
+	// Without edges, a single row is given a unit height
+	// about its coordinate.
+	h.YEdges = nil
+	if _, _, ymin, ymax := h.DataRange(); ymin != -0.5 || ymax != 0.5 {
+		t.Errorf("unexpected Y range of single row: got:[%v %v] want:[-0.5 0.5]", ymin, ymax)
+	}
+
+	// Edges that do not match the grid are reported
+	// by DataRange, which is called before Plot.
+	h.XEdges = []float64{0, 1, 3}
+	func() {
+		defer func() {
+			r := recover()
+			if r != "heatmap: number of X edges does not match number of columns" {
+				t.Errorf("unexpected panic for mismatched edges: got:%v", r)
+			}
+		}()
+		h.DataRange()
+	}()
+}
+
+func TestHeatMapLabels(t *testing.T) {
+	m := offsetUnitGrid{Data: mat.NewDense(1, 3, []float64{1, 3, 2})}
+	h := NewHeatMap(m, colorList{color.Black, color.White})
+	h.LabelFormat = "%.0f"
+	if h.LabelStyle.Font.Size == 0 {
+		t.Skip("default font not available")
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(h)
+	var rec recorder.Canvas
+	h.Plot(draw.NewCanvas(&rec, 300, 100), p)
+	var labels []string
+	var colors []color.Color
+	var last color.Color
+	for _, a := range rec.Actions {
+		switch a := a.(type) {
+		case *recorder.SetColor:
+			last = a.Color
+		case *recorder.FillString:
+			labels = append(labels, a.String)
+			colors = append(colors, last)
+		}
+	}
+	// The cell of 1 is black, and the cells
+	// of 3 and 2 are white.
+	wantLabels := []string{"1", "3", "2"}
+	wantColors := []color.Color{color.White, color.Black, color.Black}
+	if len(labels) != len(wantLabels) {
+		t.Fatalf("unexpected labels: got:%v want:%v", labels, wantLabels)
+	}
+	for i, l := range labels {
+		if l != wantLabels[i] || colors[i] != wantColors[i] {
+			t.Errorf("unexpected label %d: got:%q in %v want:%q in %v", i, l, colors[i], wantLabels[i], wantColors[i])
+		}
+	}
+
+	// Labels that do not fit in their cells are omitted.
+	rec.Reset()
+	h.Plot(draw.NewCanvas(&rec, 3, 1), p)
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			t.Errorf("unexpected label in small cell: %q", a.String)
+		}
+	}
+}
+
+func TestContrastColor(t *testing.T) {
+	for _, test := range []struct {
+		c    color.Color
+		want color.Color
+	}{
+		{c: nil, want: color.Black},
+		{c: color.Black, want: color.White},
+		{c: color.White, want: color.Black},
+		{c: color.RGBA{R: 255, A: 255}, want: color.Black},
+		{c: color.RGBA{B: 255, A: 255}, want: color.White},
+		{c: color.RGBA{G: 255, A: 255}, want: color.Black},
+		{c: color.Gray{Y: 100}, want: color.White},
+		{c: color.Gray{Y: 140}, want: color.Black},
+	} {
+		if got := contrastColor(test.c); got != test.want {
+			t.Errorf("unexpected contrast color for %v: got:%v want:%v", test.c, got, test.want)
+		}
+	}
+}
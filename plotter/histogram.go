@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"image/color"
 	"math"
+	"sort"
 
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/vg"
@@ -21,9 +22,22 @@ type Histogram struct {
 	// Bins is the set of bins for this histogram.
 	Bins []HistogramBin
 
-	// Width is the width of each bin.
+	// Width is the width of each bin. Width is zero
+	// if the bins do not all have the same width.
 	Width float64
 
+	// Underflow and Overflow hold the weight of the
+	// values below the first bin and above the last
+	// bin. Their Min and Max give the range of values
+	// they cover, and are infinite on the outer side.
+	Underflow, Overflow HistogramBin
+
+	// ShowOverflow specifies whether the Underflow
+	// and Overflow bins are drawn, as bars beside the
+	// first and last bins with the same width as their
+	// neighbours.
+	ShowOverflow bool
+
 	// FillColor is the color used to fill each
 	// bar of the histogram.  If the color is nil
 	// then the bars are not filled.
@@ -32,6 +46,112 @@ type Histogram struct {
 	// LineStyle is the style of the outline of each
 	// bar of the histogram.
 	draw.LineStyle
+
+	// Style is the way the histogram is drawn.
+	Style HistogramStyle
+
+	// GlyphStyle is the style of the points
+	// drawn in the HistogramPoints style.
+	GlyphStyle draw.GlyphStyle
+
+	// Uncertainty is the way the uncertainty of
+	// the weight of each bin is calculated.
+	Uncertainty HistogramUncertainty
+
+	// ErrorStyle is the style of the error bars
+	// drawn in the HistogramPoints style.
+	ErrorStyle draw.LineStyle
+
+	// ErrorFill is the color of boxes spanning
+	// the uncertainty of each bin. If ErrorFill
+	// is nil, no boxes are drawn.
+	ErrorFill color.Color
+
+	// Horizontal dictates whether the bars should be in the vertical
+	// (default) or horizontal direction. If Horizontal is true, the
+	// bins are placed along the Y axis and their weights along the
+	// X axis.
+	Horizontal bool
+
+	// LogY specifies that the weights are drawn on an
+	// axis using plot.LogScale, the X axis if Horizontal
+	// is true. The data range of the weights then starts
+	// below the smallest positive weight rather than at
+	// zero, which cannot be drawn on a logarithmic axis.
+	LogY bool
+
+	// stats holds the statistics of the binned
+	// values.
+	stats histStats
+
+	// cumulative is whether the bin weights
+	// have been accumulated by Cumulative.
+	cumulative bool
+
+	// stackedOn is the histogram upon which
+	// this histogram is stacked.
+	stackedOn *Histogram
+}
+
+// HistogramStyle is a way of drawing a histogram.
+type HistogramStyle int
+
+const (
+	// HistogramBars draws each bin as a bar.
+	HistogramBars HistogramStyle = iota
+
+	// HistogramStep draws the outline of the histogram
+	// as a step line without filling it, so that several
+	// histograms may be overlaid.
+	HistogramStep
+
+	// HistogramPoints draws each bin as a point at the
+	// bin center with an error bar spanning the bin's
+	// uncertainty, as is usual for measured data.
+	HistogramPoints
+)
+
+// HistogramUncertainty is a way of calculating the
+// uncertainty of the weight of a histogram bin.
+type HistogramUncertainty int
+
+const (
+	// PoissonUncertainty is the square root of the
+	// bin weight. It is the uncertainty of a count of
+	// unweighted entries that has not been rescaled.
+	PoissonUncertainty HistogramUncertainty = iota
+
+	// SumW2Uncertainty is the square root of the sum
+	// of the squared weights of the bin's entries. It
+	// is suited to weighted entries, and follows the
+	// rescaling of the bins by Normalize and Density.
+	SumW2Uncertainty
+)
+
+// histStats accumulates statistics of weighted values.
+type histStats struct {
+	entries int
+	sumW    float64
+	sumWX   float64
+	sumWX2  float64
+}
+
+// newHistStats returns the statistics of the values of xy,
+// counting every value as an entry but summing only those
+// from min to max, which are within the bins.
+func newHistStats(xy XYer, min, max float64) histStats {
+	var s histStats
+	for i := 0; i < xy.Len(); i++ {
+		x, w := xy.XY(i)
+		s.entries++
+		if x < min || x > max {
+			continue
+		}
+		s.sumW += w
+		s.sumWX += w * x
+		s.sumWX2 += w * x * x
+	}
+	return s
 }
 
 // NewHistogram returns a new histogram
@@ -49,10 +169,15 @@ func NewHistogram(xy XYer, n int) (*Histogram, error) {
 	}
 	bins, width := binPoints(xy, n)
 	return &Histogram{
-		Bins:      bins,
-		Width:     width,
-		FillColor: color.Gray{128},
-		LineStyle: DefaultLineStyle,
+		stats:      newHistStats(xy, math.Inf(-1), math.Inf(1)),
+		Bins:       bins,
+		Width:      width,
+		Underflow:  HistogramBin{Min: math.Inf(-1), Max: bins[0].Min},
+		Overflow:   HistogramBin{Min: bins[len(bins)-1].Max, Max: math.Inf(1)},
+		FillColor:  color.Gray{128},
+		LineStyle:  DefaultLineStyle,
+		GlyphStyle: DefaultGlyphStyle,
+		ErrorStyle: DefaultLineStyle,
 	}, nil
 }
 
@@ -63,6 +188,166 @@ func NewHist(vs Valuer, n int) (*Histogram, error) {
 	return NewHistogram(unitYs{vs}, n)
 }
 
+// NewHistogramRule returns a new histogram that represents the
+// distribution of values, as in NewHistogram, with the number
+// of bins chosen by the given rule.
+func NewHistogramRule(xy XYer, rule BinRule) (*Histogram, error) {
+	if xy.Len() == 0 {
+		return nil, ErrNoData
+	}
+	xs := make([]float64, xy.Len())
+	for i := range xs {
+		xs[i], _ = xy.XY(i)
+	}
+	sort.Float64s(xs)
+	return NewHistogram(xy, rule(xs))
+}
+
+// NewHistogramEdges returns a new histogram that represents the
+// distribution of values using bins with the given edges, which
+// must be strictly increasing. The ith bin holds the values from
+// edges[i] up to but not including edges[i+1], except that the
+// last bin also holds the values equal to the last edge. Values
+// outside the bins are added to the Underflow and Overflow bins.
+// An error is returned if a value or weight is NaN or infinite.
+//
+// Each y value is assumed to be the frequency count, or weight,
+// for the corresponding x.
+func NewHistogramEdges(xy XYer, edges []float64) (*Histogram, error) {
+	if len(edges) < 2 {
+		return nil, errors.New("plotter: histogram needs at least two bin edges")
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			return nil, fmt.Errorf("plotter: histogram bin edges not increasing at %d", i)
+		}
+	}
+	if err := CheckFloats(edges...); err != nil {
+		return nil, err
+	}
+
+	n := len(edges) - 1
+	h := &Histogram{
+		Bins:       make([]HistogramBin, n),
+		Underflow:  HistogramBin{Min: math.Inf(-1), Max: edges[0]},
+		Overflow:   HistogramBin{Min: edges[n], Max: math.Inf(1)},
+		FillColor:  color.Gray{128},
+		LineStyle:  DefaultLineStyle,
+		GlyphStyle: DefaultGlyphStyle,
+		ErrorStyle: DefaultLineStyle,
+	}
+	for i := range h.Bins {
+		h.Bins[i].Min = edges[i]
+		h.Bins[i].Max = edges[i+1]
+	}
+	h.Width = edges[1] - edges[0]
+	for _, b := range h.Bins[1:] {
+		if b.Max-b.Min != h.Width {
+			h.Width = 0
+			break
+		}
+	}
+
+	for i := 0; i < xy.Len(); i++ {
+		x, y := xy.XY(i)
+		if err := CheckFloats(x, y); err != nil {
+			return nil, err
+		}
+		switch {
+		case x < edges[0]:
+			h.Underflow.Weight += y
+			h.Underflow.SumW2 += y * y
+		case x > edges[n]:
+			h.Overflow.Weight += y
+			h.Overflow.SumW2 += y * y
+		default:
+			// Find the bin whose upper edge
+			// is the first above x.
+			bin := sort.SearchFloat64s(edges, x)
+			if edges[bin] != x || bin == n {
+				bin--
+			}
+			h.Bins[bin].Weight += y
+			h.Bins[bin].SumW2 += y * y
+		}
+	}
+	h.stats = newHistStats(xy, edges[0], edges[n])
+	return h, nil
+}
+
+// LogBinEdges returns n+1 histogram bin edges from min to max
+// spaced evenly on a logarithmic scale, for use with
+// NewHistogramEdges. Bins with these edges have equal widths
+// when drawn on an axis using plot.LogScale. Both min and max
+// must be positive.
+func LogBinEdges(min, max float64, n int) ([]float64, error) {
+	if n < 1 {
+		return nil, errors.New("plotter: non-positive number of histogram bins")
+	}
+	if !(0 < min && min < max) || math.IsInf(max, 1) {
+		return nil, fmt.Errorf("plotter: invalid logarithmic bin range [%g, %g]", min, max)
+	}
+	edges := make([]float64, n+1)
+	lmin, lmax := math.Log(min), math.Log(max)
+	for i := range edges {
+		edges[i] = math.Exp(lmin + float64(i)*(lmax-lmin)/float64(n))
+	}
+	edges[0], edges[n] = min, max
+	return edges, nil
+}
+
+// A BinRule returns the number of histogram bins to use for
+// the given x values, which are sorted in increasing order.
+// Weights are not taken into account.
+type BinRule func(x []float64) int
+
+// Sturges is a BinRule returning ⌈log₂n⌉+1 bins for n values.
+// It is suited to data with an approximately normal distribution.
+func Sturges(x []float64) int {
+	if len(x) == 0 {
+		return 1
+	}
+	return int(math.Ceil(math.Log2(float64(len(x))))) + 1
+}
+
+// Scott is a BinRule choosing bins of width 3.49σn^(-1/3) for
+// n values with standard deviation σ.
+func Scott(x []float64) int {
+	n := float64(len(x))
+	var mean, ss float64
+	for _, v := range x {
+		mean += v
+	}
+	mean /= n
+	for _, v := range x {
+		ss += (v - mean) * (v - mean)
+	}
+	sd := math.Sqrt(ss / (n - 1))
+	return binsOfWidth(x, 3.49*sd*math.Cbrt(1/n))
+}
+
+// FreedmanDiaconis is a BinRule choosing bins of width
+// 2 IQR n^(-1/3) for n values with interquartile range IQR.
+// It is less sensitive to outliers than Scott.
+func FreedmanDiaconis(x []float64) int {
+	n := float64(len(x))
+	iqr := QuantileR7.Quantile(x, 0.75) - QuantileR7.Quantile(x, 0.25)
+	return binsOfWidth(x, 2*iqr*math.Cbrt(1/n))
+}
+
+// binsOfWidth returns the number of bins of width w needed to
+// cover the sorted values in x, or one if w is not positive.
+func binsOfWidth(x []float64, w float64) int {
+	if len(x) < 2 || !(w > 0) {
+		return 1
+	}
+	n := math.Ceil((x[len(x)-1] - x[0]) / w)
+	if n < 1 {
+		return 1
+	}
+	return int(n)
+}
+
 type unitYs struct {
 	Valuer
 }
@@ -73,69 +358,465 @@ func (u unitYs) XY(i int) (float64, float64) {
 
 // Plot implements the Plotter interface, drawing a line
 // that connects each point in the Line.
+//
+// If the axis of the weights uses plot.LogScale,
+// the bars start at the bottom of the axis rather
+// than at zero, and LogY should be set so that the
+// data range of the weights is positive.
 func (h *Histogram) Plot(c draw.Canvas, p *plot.Plot) {
-	trX, trY := p.Transforms(&c)
+	trBin, trW := p.Transforms(&c)
+	wAxis := p.Y
+	if h.Horizontal {
+		trBin, trW = trW, trBin
+		wAxis = p.X
+	}
+
+	var base vg.Length
+	if _, ok := wAxis.Scale.(plot.LogScale); ok {
+		// Non-positive weights, such as those of
+		// empty bins, are drawn at the bottom of
+		// the axis.
+		base = trW(wAxis.Min)
+		tr := trW
+		trW = func(w float64) vg.Length {
+			if w <= 0 {
+				return base
+			}
+			return tr(w)
+		}
+	} else {
+		base = trW(0)
+	}
+	bins := h.drawnBins()
+
+	switch h.Style {
+	case HistogramStep:
+		h.plotStep(c, trBin, trW, base, bins)
+	case HistogramBars:
+		for j, bin := range bins {
+			bottom := base
+			if sb := h.stackBase(j); sb != 0 {
+				bottom = trW(sb)
+			}
+			top := trW(h.stackBase(j) + bin.Weight)
+			pts := []vg.Point{
+				h.point(trBin(bin.Min), bottom),
+				h.point(trBin(bin.Max), bottom),
+				h.point(trBin(bin.Max), top),
+				h.point(trBin(bin.Min), top),
+			}
+			if h.FillColor != nil {
+				c.FillPolygon(h.FillColor, c.ClipPolygonXY(pts))
+			}
+			pts = append(pts, pts[0])
+			c.StrokeLines(h.LineStyle, c.ClipLinesXY(pts)...)
+		}
+	}
 
-	for _, bin := range h.Bins {
-		pts := []vg.Point{
-			{trX(bin.Min), trY(0)},
-			{trX(bin.Max), trY(0)},
-			{trX(bin.Max), trY(bin.Weight)},
-			{trX(bin.Min), trY(bin.Weight)},
+	if h.ErrorFill != nil {
+		for j, bin := range bins {
+			top := h.stackBase(j) + bin.Weight
+			e := h.uncertainty(bin)
+			pts := []vg.Point{
+				h.point(trBin(bin.Min), trW(top-e)),
+				h.point(trBin(bin.Max), trW(top-e)),
+				h.point(trBin(bin.Max), trW(top+e)),
+				h.point(trBin(bin.Min), trW(top+e)),
+			}
+			c.FillPolygon(h.ErrorFill, c.ClipPolygonXY(pts))
 		}
-		if h.FillColor != nil {
-			c.FillPolygon(h.FillColor, c.ClipPolygonXY(pts))
+	}
+
+	if h.Style == HistogramPoints {
+		for j, bin := range bins {
+			top := h.stackBase(j) + bin.Weight
+			x := trBin(h.binCenter(bin))
+			e := h.uncertainty(bin)
+			bar := c.ClipLinesXY([]vg.Point{h.point(x, trW(top-e)), h.point(x, trW(top+e))})
+			c.StrokeLines(h.ErrorStyle, bar...)
+			if pt := h.point(x, trW(top)); c.Contains(pt) {
+				c.DrawGlyph(h.GlyphStyle, pt)
+			}
+		}
+	}
+}
+
+// point returns the point on the canvas at the given
+// positions along the bins and along the weights.
+func (h *Histogram) point(bin, w vg.Length) vg.Point {
+	if h.Horizontal {
+		return vg.Point{X: w, Y: bin}
+	}
+	return vg.Point{X: bin, Y: w}
+}
+
+// plotStep draws the outline of the bins as a step line.
+// The line drops to the base at the ends of the histogram
+// and wherever consecutive bins are not adjacent.
+func (h *Histogram) plotStep(c draw.Canvas, trBin, trW func(float64) vg.Length, base vg.Length, bins []HistogramBin) {
+	var pts []vg.Point
+	var last vg.Length
+	for i, bin := range bins {
+		if i == 0 || bin.Min != bins[i-1].Max {
+			if len(pts) != 0 {
+				pts = append(pts, h.point(last, base))
+				c.StrokeLines(h.LineStyle, c.ClipLinesXY(pts)...)
+			}
+			pts = []vg.Point{h.point(trBin(bin.Min), base)}
 		}
-		pts = append(pts, vg.Point{X: trX(bin.Min), Y: trY(0)})
+		w := trW(h.stackBase(i) + bin.Weight)
+		last = trBin(bin.Max)
+		pts = append(pts, h.point(trBin(bin.Min), w), h.point(last, w))
+	}
+	if len(pts) != 0 {
+		pts = append(pts, h.point(last, base))
 		c.StrokeLines(h.LineStyle, c.ClipLinesXY(pts)...)
 	}
 }
 
+// StackOn stacks the histogram on top of another, so
+// that each bar starts at the top of the corresponding
+// bar of the histogram upon which it is stacked. The
+// histograms must have the same bins, and the same
+// ShowOverflow setting, and an error is returned if
+// their bin edges differ.
+func (h *Histogram) StackOn(on *Histogram) error {
+	if err := compatibleBins(h, on); err != nil {
+		return err
+	}
+	for b := on; b != nil; b = b.stackedOn {
+		if b == h {
+			return errors.New("plotter: histogram stacked on itself")
+		}
+	}
+	h.stackedOn = on
+	return nil
+}
+
+// compatibleBins returns an error if the bins of a and b
+// differ in number or edges.
+func compatibleBins(a, b *Histogram) error {
+	if len(a.Bins) != len(b.Bins) {
+		return fmt.Errorf("plotter: histograms have %d and %d bins", len(a.Bins), len(b.Bins))
+	}
+	const tol = 1e-12
+	for i := range a.Bins {
+		ba, bb := a.Bins[i], b.Bins[i]
+		scale := math.Max(math.Abs(ba.Max-ba.Min), math.Abs(bb.Max-bb.Min))
+		if math.Abs(ba.Min-bb.Min) > tol*scale || math.Abs(ba.Max-bb.Max) > tol*scale {
+			return fmt.Errorf("plotter: histogram bin %d edges differ: [%g, %g] and [%g, %g]",
+				i, ba.Min, ba.Max, bb.Min, bb.Max)
+		}
+	}
+	return nil
+}
+
+// stackBase returns the value at which the jth drawn bin
+// starts, taking into account any histograms upon which
+// it is stacked.
+func (h *Histogram) stackBase(j int) float64 {
+	if h.ShowOverflow {
+		j--
+	}
+	return h.binBase(j)
+}
+
+// binBase returns the value at which the ith bin starts,
+// taking into account any histograms upon which it is
+// stacked. The Underflow bin has the index -1 and the
+// Overflow bin the index len(h.Bins).
+func (h *Histogram) binBase(i int) float64 {
+	var base float64
+	for b := h.stackedOn; b != nil; b = b.stackedOn {
+		switch {
+		case i < 0:
+			base += b.Underflow.Weight
+		case i >= len(b.Bins):
+			base += b.Overflow.Weight
+		default:
+			base += b.Bins[i].Weight
+		}
+	}
+	return base
+}
+
+// binCenter returns the center of the bin, which is the
+// geometric mean of its edges if the bins are
+// logarithmically spaced.
+func (h *Histogram) binCenter(bin HistogramBin) float64 {
+	if h.logSpaced() {
+		return math.Sqrt(bin.Min * bin.Max)
+	}
+	return (bin.Min + bin.Max) / 2
+}
+
+// uncertainty returns the uncertainty of the weight of bin.
+func (h *Histogram) uncertainty(bin HistogramBin) float64 {
+	if h.Uncertainty == SumW2Uncertainty {
+		return math.Sqrt(bin.SumW2)
+	}
+	return math.Sqrt(math.Abs(bin.Weight))
+}
+
+// BinError returns the uncertainty of the weight of the ith bin.
+func (h *Histogram) BinError(i int) float64 {
+	return h.uncertainty(h.Bins[i])
+}
+
+// ErrorBars returns error bars spanning the uncertainty of
+// each bin, placed at the bin centers and at the top of the
+// bins if the histogram is stacked.
+func (h *Histogram) ErrorBars() (*YErrorBars, error) {
+	return NewYErrorBars(histogramErrors{h})
+}
+
+// histogramErrors implements the XYer and YErrorer
+// interfaces for the bins of a histogram.
+type histogramErrors struct {
+	h *Histogram
+}
+
+func (e histogramErrors) Len() int { return len(e.h.Bins) }
+
+func (e histogramErrors) XY(i int) (x, y float64) {
+	return e.h.binCenter(e.h.Bins[i]), e.h.binBase(i) + e.h.Bins[i].Weight
+}
+
+func (e histogramErrors) YError(i int) (low, high float64) {
+	err := e.h.BinError(i)
+	return err, err
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface,
+// returning the boxes of the points of the
+// HistogramPoints style.
+func (h *Histogram) GlyphBoxes(p *plot.Plot) []plot.GlyphBox {
+	if h.Style != HistogramPoints {
+		return nil
+	}
+	bins := h.drawnBins()
+	bs := make([]plot.GlyphBox, len(bins))
+	for i, bin := range bins {
+		x, y := h.binCenter(bin), h.stackBase(i)+bin.Weight
+		if h.Horizontal {
+			x, y = y, x
+		}
+		bs[i].X = p.X.Norm(x)
+		bs[i].Y = p.Y.Norm(y)
+		bs[i].Rectangle = h.GlyphStyle.Rectangle()
+	}
+	return bs
+}
+
+// drawnBins returns the bins to draw, including the
+// Underflow and Overflow bins if ShowOverflow is true.
+// The flow bins are given the width of their neighbours,
+// measured on a logarithmic scale if the bins are
+// logarithmically spaced.
+func (h *Histogram) drawnBins() []HistogramBin {
+	if !h.ShowOverflow || len(h.Bins) == 0 {
+		return h.Bins
+	}
+	first, last := h.Bins[0], h.Bins[len(h.Bins)-1]
+	under, over := h.Underflow, h.Overflow
+	if h.logSpaced() {
+		under.Min, under.Max = first.Min*first.Min/first.Max, first.Min
+		over.Min, over.Max = last.Max, last.Max*last.Max/last.Min
+	} else {
+		under.Min, under.Max = 2*first.Min-first.Max, first.Min
+		over.Min, over.Max = last.Max, 2*last.Max-last.Min
+	}
+	bins := make([]HistogramBin, 0, len(h.Bins)+2)
+	bins = append(bins, under)
+	bins = append(bins, h.Bins...)
+	return append(bins, over)
+}
+
+// logSpaced returns whether the bins are positive, not
+// equally wide and have the same ratio of Max to Min.
+func (h *Histogram) logSpaced() bool {
+	if len(h.Bins) < 2 || h.Width != 0 || h.Bins[0].Min <= 0 {
+		return false
+	}
+	r := h.Bins[0].Max / h.Bins[0].Min
+	for _, b := range h.Bins[1:] {
+		if math.Abs(b.Max/b.Min-r) > 1e-9*r {
+			return false
+		}
+	}
+	return true
+}
+
 // DataRange returns the minimum and maximum X and Y values
 func (h *Histogram) DataRange() (xmin, xmax, ymin, ymax float64) {
 	xmin = math.Inf(1)
 	xmax = math.Inf(-1)
 	ymax = math.Inf(-1)
-	for _, bin := range h.Bins {
+
+	// low is the smallest positive drawn weight, used
+	// as the lower end of the range of the weights when
+	// they are drawn on a logarithmic axis.
+	low := math.Inf(1)
+	for j, bin := range h.drawnBins() {
 		if bin.Max > xmax {
 			xmax = bin.Max
 		}
 		if bin.Min < xmin {
 			xmin = bin.Min
 		}
-		if bin.Weight > ymax {
-			ymax = bin.Weight
+		top := h.stackBase(j) + bin.Weight
+		if top > ymax {
+			ymax = top
+		}
+		if top > 0 {
+			low = math.Min(low, top)
+		}
+		if h.Style == HistogramPoints || h.ErrorFill != nil {
+			e := h.uncertainty(bin)
+			ymin = math.Min(ymin, top-e)
+			ymax = math.Max(ymax, top+e)
+			if top-e > 0 {
+				low = math.Min(low, top-e)
+			}
+		}
+	}
+	if h.LogY {
+		// Leave room to show the bar of
+		// the smallest positive weight.
+		ymin = low / 2
+	}
+	if h.Horizontal {
+		return ymin, ymax, xmin, xmax
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// HistogramStats holds summary statistics of
+// the values in a histogram.
+type HistogramStats struct {
+	// Entries is the number of values, including
+	// those in the underflow and overflow bins.
+	Entries int
+
+	// Integral is the sum of the weights of the
+	// values within the bins.
+	Integral float64
+
+	// Mean and RMS are the weighted mean and root
+	// mean square deviation from the mean of the
+	// values within the bins.
+	Mean, RMS float64
+
+	// Underflow and Overflow are the weights of
+	// the underflow and overflow bins.
+	Underflow, Overflow float64
+}
+
+// Stats returns summary statistics of the histogram.
+// The statistics are calculated from the values given
+// to the histogram's constructor, so they are not
+// changed by Normalize, Density or Cumulative. If the
+// histogram was not constructed from values, the
+// statistics are calculated from the bin centers and
+// weights, and Entries is zero.
+func (h *Histogram) Stats() HistogramStats {
+	s := h.stats
+	if s.entries == 0 {
+		for _, b := range h.Bins {
+			x := (b.Min + b.Max) / 2
+			s.sumW += b.Weight
+			s.sumWX += b.Weight * x
+			s.sumWX2 += b.Weight * x * x
 		}
 	}
-	return
+	st := HistogramStats{
+		Entries:   s.entries,
+		Integral:  s.sumW,
+		Underflow: h.Underflow.Weight,
+		Overflow:  h.Overflow.Weight,
+	}
+	if s.sumW != 0 {
+		st.Mean = s.sumWX / s.sumW
+		st.RMS = math.Sqrt(math.Max(s.sumWX2/s.sumW-st.Mean*st.Mean, 0))
+	}
+	return st
 }
 
 // Normalize normalizes the histogram so that the
 // total area beneath it sums to a given value.
+// The Underflow and Overflow bins are not changed.
 func (h *Histogram) Normalize(sum float64) {
 	mass := 0.0
 	for _, b := range h.Bins {
 		mass += b.Weight
 	}
+	for i, b := range h.Bins {
+		f := sum / ((b.Max - b.Min) * mass)
+		h.Bins[i].Weight *= f
+		h.Bins[i].SumW2 *= f * f
+	}
+}
+
+// Density divides the weight of each bin by its width, so
+// that the area of each bar is the weight of its bin. This
+// gives a faithful picture of the distribution when the bins
+// differ in width. The Underflow and Overflow bins are not
+// changed.
+func (h *Histogram) Density() {
+	for i, b := range h.Bins {
+		w := b.Max - b.Min
+		h.Bins[i].Weight /= w
+		h.Bins[i].SumW2 /= w * w
+	}
+}
+
+// Cumulative replaces the weight of each bin with the sum
+// of the weights of all the values up to the end of the bin,
+// including the Underflow bin. The total weight of the
+// histogram, by which the bin weights may be divided to give
+// the empirical cumulative distribution, is the weight of the
+// last bin plus the weight of the Overflow bin. The Underflow
+// and Overflow bins are not changed.
+//
+// The SumW2 of each bin is accumulated in the same way,
+// ignoring the correlation between the bins. Calling
+// Cumulative again has no effect.
+func (h *Histogram) Cumulative() {
+	if h.cumulative {
+		return
+	}
+	h.cumulative = true
+	sum, sumW2 := h.Underflow.Weight, h.Underflow.SumW2
 	for i := range h.Bins {
-		h.Bins[i].Weight *= sum / (h.Width * mass)
+		sum += h.Bins[i].Weight
+		sumW2 += h.Bins[i].SumW2
+		h.Bins[i].Weight = sum
+		h.Bins[i].SumW2 = sumW2
 	}
 }
 
 // Thumbnail draws a rectangle in the given style of the histogram.
+// In the HistogramPoints style it draws a point with an error bar.
 func (h *Histogram) Thumbnail(c *draw.Canvas) {
 	ymin := c.Min.Y
 	ymax := c.Max.Y
 	xmin := c.Min.X
 	xmax := c.Max.X
 
+	if h.Style == HistogramPoints {
+		x := c.Center().X
+		c.StrokeLine2(h.ErrorStyle, x, ymin, x, ymax)
+		c.DrawGlyph(h.GlyphStyle, c.Center())
+		return
+	}
+
 	pts := []vg.Point{
 		{xmin, ymin},
 		{xmax, ymin},
 		{xmax, ymax},
 		{xmin, ymax},
 	}
-	if h.FillColor != nil {
+	if h.FillColor != nil && h.Style == HistogramBars {
 		c.FillPolygon(h.FillColor, c.ClipPolygonXY(pts))
 	}
 	pts = append(pts, vg.Point{X: xmin, Y: ymin})
@@ -183,6 +864,7 @@ func binPoints(xys XYer, n int) ([]HistogramBin, float64) {
 				x, xmin, xmax, w, bin, n))
 		}
 		bins[bin].Weight += y
+		bins[bin].SumW2 += y * y
 	}
 	return bins, w
 }
@@ -192,4 +874,8 @@ func binPoints(xys XYer, n int) ([]HistogramBin, float64) {
 type HistogramBin struct {
 	Min, Max float64
 	Weight   float64
+
+	// SumW2 is the sum of the squares of
+	// the weights of the values in the bin.
+	SumW2 float64
 }
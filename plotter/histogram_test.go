@@ -14,6 +14,8 @@ import (
 	"gonum.org/v1/plot"
 	"gonum.org/v1/plot/internal/cmpimg"
 	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
 )
 
 // An example of making a histogram.
@@ -62,3 +64,379 @@ func ExampleHistogram() {
 func TestHistogram(t *testing.T) {
 	cmpimg.CheckPlot(ExampleHistogram, t, "histogram.png")
 }
+
+// This example shows a histogram with logarithmically
+// spaced bins drawn on a logarithmic X axis.
+func ExampleHistogram_logBins() {
+	rnd := rand.New(rand.NewSource(1))
+	vals := make(Values, 10000)
+	for i := range vals {
+		vals[i] = math.Exp(rnd.NormFloat64())
+	}
+
+	edges, err := LogBinEdges(0.1, 10, 20)
+	if err != nil {
+		log.Panic(err)
+	}
+	h, err := NewHistogramEdges(unitYs{vals}, edges)
+	if err != nil {
+		log.Panic(err)
+	}
+	h.ShowOverflow = true
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Log-normal distribution"
+	p.X.Scale = plot.LogScale{}
+	p.X.Tick.Marker = plot.LogTicks{}
+	p.Add(h)
+
+	err = p.Save(200, 200, "testdata/histogramLogBins.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestHistogram_logBins(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHistogram_logBins, t, "histogramLogBins.png")
+}
+
+func TestHistogramEdges(t *testing.T) {
+	data := XYs{
+		{X: -1, Y: 1}, {X: 0, Y: 2}, {X: 0.5, Y: 1}, {X: 1, Y: 3},
+		{X: 2.5, Y: 0.5}, {X: 4, Y: 1}, {X: 4.5, Y: 2},
+	}
+	h, err := NewHistogramEdges(data, []float64{0, 1, 2, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []float64{3, 3, 1.5} {
+		if got := h.Bins[i].Weight; got != want {
+			t.Errorf("unexpected weight for bin %d: got:%v want:%v", i, got, want)
+		}
+	}
+	if h.Underflow.Weight != 1 || h.Overflow.Weight != 2 {
+		t.Errorf("unexpected flow weights: got:%v,%v want:1,2", h.Underflow.Weight, h.Overflow.Weight)
+	}
+	if h.Width != 0 {
+		t.Errorf("unexpected width for variable bins: got:%v want:0", h.Width)
+	}
+	if xmin, xmax, _, _ := h.DataRange(); xmin != 0 || xmax != 4 {
+		t.Errorf("unexpected x range: got:[%v, %v] want:[0, 4]", xmin, xmax)
+	}
+	h.ShowOverflow = true
+	if xmin, xmax, _, _ := h.DataRange(); xmin != -1 || xmax != 6 {
+		t.Errorf("unexpected x range with overflow: got:[%v, %v] want:[-1, 6]", xmin, xmax)
+	}
+
+	h.Density()
+	for i, want := range []float64{3, 3, 0.75} {
+		if got := h.Bins[i].Weight; got != want {
+			t.Errorf("unexpected density for bin %d: got:%v want:%v", i, got, want)
+		}
+	}
+
+	for _, edges := range [][]float64{nil, {1}, {0, 1, 1}, {0, math.NaN()}} {
+		if _, err := NewHistogramEdges(data, edges); err == nil {
+			t.Errorf("expected error for edges %v", edges)
+		}
+	}
+	for _, xy := range []XYs{{{X: math.NaN(), Y: 1}}, {{X: 1, Y: math.Inf(1)}}} {
+		if _, err := NewHistogramEdges(xy, []float64{0, 1, 2}); err == nil {
+			t.Errorf("expected error for data %v", xy)
+		}
+	}
+}
+
+func TestHistogramLogBins(t *testing.T) {
+	edges, err := LogBinEdges(1, 1000, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []float64{1, 10, 100, 1000} {
+		if math.Abs(edges[i]-want) > 1e-9*want {
+			t.Errorf("unexpected edge %d: got:%v want:%v", i, edges[i], want)
+		}
+	}
+	h, err := NewHistogramEdges(XYs{{X: 5, Y: 1}}, edges)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h.ShowOverflow = true
+	xmin, xmax, _, _ := h.DataRange()
+	if math.Abs(xmin-0.1) > 1e-9 || math.Abs(xmax-10000) > 1e-6 {
+		t.Errorf("unexpected x range with overflow: got:[%v, %v] want:[0.1, 10000]", xmin, xmax)
+	}
+
+	for _, r := range [][2]float64{{0, 1}, {2, 1}, {-1, 1}} {
+		if _, err := LogBinEdges(r[0], r[1], 3); err == nil {
+			t.Errorf("expected error for range %v", r)
+		}
+	}
+}
+
+func TestHistogramCumulative(t *testing.T) {
+	h, err := NewHistogramEdges(unitYs{Values{-1, 0.5, 1.5, 1.5, 3}}, []float64{0, 1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A second call leaves the weights unchanged,
+	// and the flow bins are never changed.
+	for i := 0; i < 2; i++ {
+		h.Cumulative()
+		if h.Bins[0].Weight != 2 || h.Bins[1].Weight != 4 {
+			t.Errorf("unexpected cumulative weights after %d calls: got:%v,%v want:2,4",
+				i+1, h.Bins[0].Weight, h.Bins[1].Weight)
+		}
+		if h.Underflow.Weight != 1 || h.Overflow.Weight != 1 {
+			t.Errorf("unexpected flow weights after %d calls: got:%v,%v want:1,1",
+				i+1, h.Underflow.Weight, h.Overflow.Weight)
+		}
+	}
+}
+
+func TestHistogramLogY(t *testing.T) {
+	h, err := NewHistogramEdges(unitYs{Values{0.5, 0.5, 0.5, 0.5, 2.5, 3.5, 3.5}}, []float64{0, 1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, ymin, ymax := h.DataRange(); ymin != 0 || ymax != 4 {
+		t.Errorf("unexpected linear y range: got:[%v, %v] want:[0, 4]", ymin, ymax)
+	}
+	h.LogY = true
+	if _, _, ymin, ymax := h.DataRange(); ymin != 0.5 || ymax != 4 {
+		t.Errorf("unexpected logarithmic y range: got:[%v, %v] want:[0.5, 4]", ymin, ymax)
+	}
+
+	// The empty bin is drawn at the bottom
+	// of the axis rather than panicking.
+	for _, style := range []HistogramStyle{HistogramBars, HistogramStep, HistogramPoints} {
+		h.Style = style
+		p, err := plot.New()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		p.Y.Scale = plot.LogScale{}
+		p.Add(h)
+		var rec recorder.Canvas
+		h.Plot(draw.NewCanvas(&rec, 100, 100), p)
+		if len(rec.Actions) == 0 {
+			t.Errorf("nothing drawn in style %v", style)
+		}
+	}
+}
+
+func TestHistogramNormalize(t *testing.T) {
+	h, err := NewHistogramEdges(unitYs{Values{0.5, 1.5, 2, 3.5}}, []float64{0, 1, 2, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h.Normalize(1)
+	var area float64
+	for _, b := range h.Bins {
+		area += b.Weight * (b.Max - b.Min)
+	}
+	if math.Abs(area-1) > 1e-12 {
+		t.Errorf("unexpected normalized area: got:%v want:1", area)
+	}
+}
+
+func TestBinRules(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	vals := make(Values, 1000)
+	for i := range vals {
+		vals[i] = rnd.NormFloat64()
+	}
+	for _, test := range []struct {
+		name     string
+		rule     BinRule
+		min, max int
+	}{
+		{name: "Sturges", rule: Sturges, min: 11, max: 11},
+		{name: "Scott", rule: Scott, min: 15, max: 25},
+		{name: "FreedmanDiaconis", rule: FreedmanDiaconis, min: 15, max: 30},
+	} {
+		h, err := NewHistogramRule(unitYs{vals}, test.rule)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", test.name, err)
+		}
+		if n := len(h.Bins); n < test.min || n > test.max {
+			t.Errorf("unexpected number of bins for %s: got:%d want:[%d, %d]", test.name, n, test.min, test.max)
+		}
+	}
+	if n := FreedmanDiaconis([]float64{1, 1, 1, 1}); n != 1 {
+		t.Errorf("unexpected number of bins for constant data: got:%d want:1", n)
+	}
+}
+
+func TestHistogramStats(t *testing.T) {
+	h, err := NewHistogramEdges(XYs{
+		{X: -5, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 4, Y: 1}, {X: 20, Y: 3},
+	}, []float64{0, 5, 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h.Normalize(1)
+	st := h.Stats()
+	want := HistogramStats{
+		Entries:   5,
+		Integral:  4,
+		Mean:      2.25,
+		RMS:       math.Sqrt(1.1875),
+		Underflow: 1,
+		Overflow:  3,
+	}
+	if st != want {
+		t.Errorf("unexpected stats: got:%+v want:%+v", st, want)
+	}
+
+	// Without values, the statistics
+	// come from the bins.
+	h = &Histogram{Bins: []HistogramBin{{Min: 0, Max: 2, Weight: 1}, {Min: 2, Max: 4, Weight: 1}}}
+	st = h.Stats()
+	if st.Entries != 0 || st.Mean != 2 || st.RMS != 1 {
+		t.Errorf("unexpected bin stats: got:%+v", st)
+	}
+}
+
+// This example shows a simulated histogram drawn as a step
+// outline with shaded uncertainties, overlaid with measured
+// data drawn as points with error bars.
+func ExampleHistogram_dataStyle() {
+	rnd := rand.New(rand.NewSource(1))
+	edges := make([]float64, 21)
+	for i := range edges {
+		edges[i] = -3 + 0.3*float64(i)
+	}
+
+	// The simulation has weighted entries.
+	sim := make(XYs, 5000)
+	for i := range sim {
+		sim[i].X = rnd.NormFloat64()
+		sim[i].Y = 0.2
+	}
+	mc, err := NewHistogramEdges(sim, edges)
+	if err != nil {
+		log.Panic(err)
+	}
+	mc.Style = HistogramStep
+	mc.Uncertainty = SumW2Uncertainty
+	mc.ErrorFill = color.RGBA{B: 255, A: 64}
+	mc.LineStyle.Color = color.RGBA{B: 255, A: 255}
+
+	data := make(Values, 1000)
+	for i := range data {
+		data[i] = rnd.NormFloat64()
+	}
+	h, err := NewHistogramEdges(unitYs{data}, edges)
+	if err != nil {
+		log.Panic(err)
+	}
+	h.Style = HistogramPoints
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Data and simulation"
+	p.Add(mc, h)
+	p.Legend.Add("simulation", mc)
+	p.Legend.Add("data", h)
+	p.Legend.Top = true
+
+	err = p.Save(200, 200, "testdata/histogramDataStyle.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestHistogram_dataStyle(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHistogram_dataStyle, t, "histogramDataStyle.png")
+}
+
+func TestHistogramUncertainty(t *testing.T) {
+	h, err := NewHistogramEdges(XYs{
+		{X: 0.5, Y: 2}, {X: 0.5, Y: 2}, {X: 1.5, Y: 3},
+	}, []float64{0, 1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := h.BinError(0); got != 2 {
+		t.Errorf("unexpected Poisson error: got:%v want:2", got)
+	}
+	h.Uncertainty = SumW2Uncertainty
+	if got := h.BinError(0); got != math.Sqrt(8) {
+		t.Errorf("unexpected sum of weights error: got:%v want:%v", got, math.Sqrt(8))
+	}
+
+	// Rescaling scales the sum of weights
+	// uncertainty with the weights.
+	h.Normalize(1)
+	if got, want := h.BinError(1), math.Sqrt(9)/7; math.Abs(got-want) > 1e-15 {
+		t.Errorf("unexpected normalized error: got:%v want:%v", got, want)
+	}
+
+	h.Style = HistogramPoints
+	_, _, ymin, ymax := h.DataRange()
+	if want := (4 + math.Sqrt(8)) / 7; ymin != 0 || math.Abs(ymax-want) > 1e-15 {
+		t.Errorf("unexpected y range: got:[%v, %v] want:[0, %v]", ymin, ymax, want)
+	}
+
+	bars, err := h.ErrorBars()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if bars.XYs[1].X != 1.5 || bars.YErrors[1].High != h.BinError(1) {
+		t.Errorf("unexpected error bar: got:%v %v", bars.XYs[1], bars.YErrors[1])
+	}
+}
+
+func TestHistogramHorizontal(t *testing.T) {
+	h, err := NewHistogramEdges(XYs{{X: 0.5, Y: 2}, {X: 1.5, Y: 5}, {X: 2.5, Y: 1}}, []float64{0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	h.Horizontal = true
+	xmin, xmax, ymin, ymax := h.DataRange()
+	if xmin != 0 || xmax != 5 || ymin != 0 || ymax != 3 {
+		t.Errorf("unexpected data range: got:%v want:[0 5 0 3]", []float64{xmin, xmax, ymin, ymax})
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(h)
+
+	// Each bar spans its bin along the Y axis
+	// and its weight along the X axis.
+	h.FillColor = color.Black
+	var rec recorder.Canvas
+	h.Plot(draw.NewCanvas(&rec, 50, 30), p)
+	var fills []vg.Path
+	for _, a := range rec.Actions {
+		if f, ok := a.(*recorder.Fill); ok {
+			fills = append(fills, f.Path)
+		}
+	}
+	want := [][2]vg.Point{
+		{{X: 0, Y: 0}, {X: 20, Y: 10}},
+		{{X: 0, Y: 10}, {X: 50, Y: 20}},
+		{{X: 0, Y: 20}, {X: 10, Y: 30}},
+	}
+	if len(fills) != len(want) {
+		t.Fatalf("unexpected number of bars: got:%d want:%d", len(fills), len(want))
+	}
+	for i, path := range fills {
+		min, max := path[0].Pos, path[0].Pos
+		for _, comp := range path[:4] {
+			min.X, min.Y = minLength(min.X, comp.Pos.X), minLength(min.Y, comp.Pos.Y)
+			max.X, max.Y = maxLength(max.X, comp.Pos.X), maxLength(max.Y, comp.Pos.Y)
+		}
+		if min != want[i][0] || max != want[i][1] {
+			t.Errorf("unexpected bar %d: got:[%v %v] want:%v", i, min, max, want[i])
+		}
+	}
+}
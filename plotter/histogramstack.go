@@ -0,0 +1,237 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// DefaultHatchSpacing is the default distance
+// between the lines of a hatched area.
+var DefaultHatchSpacing = vg.Points(4)
+
+// A HistogramStack presents several histograms with the
+// same bins stacked on one another, such as the signal
+// and background contributions to a measurement.
+type HistogramStack struct {
+	// Histograms holds the stacked histograms, the
+	// first at the bottom of the stack. The style of
+	// the individual histograms may be changed before
+	// the stack is plotted. To draw the stack with
+	// horizontal bars, all the histograms must be
+	// Horizontal.
+	Histograms []*Histogram
+
+	// Names holds the name of each histogram.
+	Names []string
+
+	// HatchStyle is the style of the lines hatching
+	// the uncertainty of the total of the stack. The
+	// uncertainty of the total is the uncertainties
+	// of the histograms added in quadrature. If the
+	// width is zero, the uncertainty is not drawn.
+	HatchStyle draw.LineStyle
+
+	// HatchSpacing is the horizontal distance
+	// between the hatch lines.
+	HatchSpacing vg.Length
+}
+
+// NewHistogramStack returns a HistogramStack of the given
+// histograms, stacked in order with the first at the bottom.
+// An error is returned if the bins of the histograms differ.
+// The names are used for the legend entries of the histograms
+// and must have the same length as hs.
+//
+// The histograms are not copied but taken over by the stack:
+// each histogram is stacked on the previous one using its
+// StackOn method, so they are drawn stacked wherever they are
+// plotted, and should be used only through the stack once it
+// has been made. If an error is returned, the histograms are
+// left unchanged.
+func NewHistogramStack(names []string, hs ...*Histogram) (*HistogramStack, error) {
+	if len(hs) == 0 {
+		return nil, ErrNoData
+	}
+	if len(names) != len(hs) {
+		return nil, fmt.Errorf("plotter: %d names for %d stacked histograms", len(names), len(hs))
+	}
+	for i, h := range hs[1:] {
+		if h.ShowOverflow != hs[0].ShowOverflow {
+			return nil, fmt.Errorf("plotter: stacked histogram %d has a different ShowOverflow setting", i+1)
+		}
+		if err := compatibleBins(h, hs[i]); err != nil {
+			return nil, err
+		}
+		for _, g := range hs[:i+1] {
+			if g == h {
+				return nil, fmt.Errorf("plotter: histogram %d appears twice in the stack", i+1)
+			}
+		}
+	}
+	for i, h := range hs[1:] {
+		if err := h.StackOn(hs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &HistogramStack{
+		Histograms:   hs,
+		Names:        names,
+		HatchSpacing: DefaultHatchSpacing,
+	}, nil
+}
+
+// Plot implements the plot.Plotter interface, drawing
+// the histograms from the bottom of the stack upwards,
+// and then the uncertainty of the total.
+func (s *HistogramStack) Plot(c draw.Canvas, plt *plot.Plot) {
+	for _, h := range s.Histograms {
+		h.Plot(c, plt)
+	}
+	if s.HatchStyle.Width == 0 {
+		return
+	}
+	trBin, trW := plt.Transforms(&c)
+	top := s.top()
+	if top.Horizontal {
+		trBin, trW = trW, trBin
+	}
+	totals, errs := s.totals()
+	for i, bin := range top.drawnBins() {
+		r := vg.Rectangle{
+			Min: top.point(trBin(bin.Min), trW(totals[i]-errs[i])),
+			Max: top.point(trBin(bin.Max), trW(totals[i]+errs[i])),
+		}
+		hatch(c, s.HatchStyle, s.HatchSpacing, r)
+	}
+}
+
+// top returns the histogram at the top of the stack.
+func (s *HistogramStack) top() *Histogram {
+	return s.Histograms[len(s.Histograms)-1]
+}
+
+// totals returns the total weight of each drawn bin
+// of the stack and its uncertainty.
+func (s *HistogramStack) totals() (totals, errs []float64) {
+	for _, h := range s.Histograms {
+		bins := h.drawnBins()
+		if totals == nil {
+			totals = make([]float64, len(bins))
+			errs = make([]float64, len(bins))
+		}
+		for j, bin := range bins {
+			totals[j] += bin.Weight
+			e := h.uncertainty(bin)
+			errs[j] += e * e
+		}
+	}
+	for j, e2 := range errs {
+		errs[j] = math.Sqrt(e2)
+	}
+	return totals, errs
+}
+
+// hatch strokes lines at 45° across the rectangle r, with the
+// given horizontal spacing. The lines are placed on a lattice
+// fixed on the canvas, so hatching of adjacent rectangles
+// lines up.
+func hatch(c draw.Canvas, sty draw.LineStyle, spacing vg.Length, r vg.Rectangle) {
+	if spacing <= 0 {
+		return
+	}
+	if r.Min.X > r.Max.X {
+		r.Min.X, r.Max.X = r.Max.X, r.Min.X
+	}
+	if r.Min.Y > r.Max.Y {
+		r.Min.Y, r.Max.Y = r.Max.Y, r.Min.Y
+	}
+	// Each line is x - y = d, for d a multiple of spacing.
+	first := math.Ceil(float64((r.Min.X - r.Max.Y) / spacing))
+	last := math.Floor(float64((r.Max.X - r.Min.Y) / spacing))
+	for k := first; k <= last; k++ {
+		d := vg.Length(k) * spacing
+		x0 := maxLength(r.Min.X, r.Min.Y+d)
+		x1 := minLength(r.Max.X, r.Max.Y+d)
+		if x0 >= x1 {
+			continue
+		}
+		line := []vg.Point{{X: x0, Y: x0 - d}, {X: x1, Y: x1 - d}}
+		c.StrokeLines(sty, c.ClipLinesXY(line)...)
+	}
+}
+
+// minLength returns the lesser of a and b.
+func minLength(a, b vg.Length) vg.Length {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The range includes the uncertainty of the total if
+// it is drawn.
+func (s *HistogramStack) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.Inf(1), math.Inf(1)
+	xmax, ymax = math.Inf(-1), math.Inf(-1)
+	for _, h := range s.Histograms {
+		hxmin, hxmax, hymin, hymax := h.DataRange()
+		xmin = math.Min(xmin, hxmin)
+		xmax = math.Max(xmax, hxmax)
+		ymin = math.Min(ymin, hymin)
+		ymax = math.Max(ymax, hymax)
+	}
+	if s.HatchStyle.Width != 0 {
+		totals, errs := s.totals()
+		for j, total := range totals {
+			if s.top().Horizontal {
+				xmin = math.Min(xmin, total-errs[j])
+				xmax = math.Max(xmax, total+errs[j])
+				continue
+			}
+			ymin = math.Min(ymin, total-errs[j])
+			ymax = math.Max(ymax, total+errs[j])
+		}
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface.
+func (s *HistogramStack) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	for _, h := range s.Histograms {
+		boxes = append(boxes, h.GlyphBoxes(plt)...)
+	}
+	return boxes
+}
+
+// Thumbnail implements the plot.Thumbnailer interface,
+// drawing the hatching of the uncertainty of the total,
+// for use as the legend entry of the uncertainty.
+func (s *HistogramStack) Thumbnail(c *draw.Canvas) {
+	hatch(*c, s.HatchStyle, s.HatchSpacing, c.Rectangle)
+}
+
+// Thumbnailers returns the histogram names and their
+// thumbnailers, to be used to add legend entries for the
+// histograms of the stack. The entries are in the order
+// the histograms appear in the plot, with the top of the
+// stack first.
+func (s *HistogramStack) Thumbnailers() (legendLabels []string, thumbnailers []plot.Thumbnailer) {
+	n := len(s.Histograms)
+	legendLabels = make([]string, n)
+	thumbnailers = make([]plot.Thumbnailer, n)
+	for i, h := range s.Histograms {
+		legendLabels[n-1-i] = s.Names[i]
+		thumbnailers[n-1-i] = h
+	}
+	return legendLabels, thumbnailers
+}
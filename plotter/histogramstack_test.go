@@ -0,0 +1,157 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+)
+
+// This example shows a signal histogram stacked on a
+// background histogram, with the uncertainty of the total.
+func ExampleHistogramStack() {
+	rnd := rand.New(rand.NewSource(1))
+	edges := make([]float64, 31)
+	for i := range edges {
+		edges[i] = 100 + 2*float64(i)
+	}
+
+	bkg := make(Values, 2000)
+	for i := range bkg {
+		bkg[i] = 100 + rnd.ExpFloat64()*30
+	}
+	sig := make(Values, 300)
+	for i := range sig {
+		sig[i] = 125 + 3*rnd.NormFloat64()
+	}
+	hb, err := NewHistogramEdges(unitYs{bkg}, edges)
+	if err != nil {
+		log.Panic(err)
+	}
+	hb.FillColor = color.RGBA{R: 120, G: 160, B: 255, A: 255}
+	hs, err := NewHistogramEdges(unitYs{sig}, edges)
+	if err != nil {
+		log.Panic(err)
+	}
+	hs.FillColor = color.RGBA{R: 255, G: 120, B: 120, A: 255}
+
+	stack, err := NewHistogramStack([]string{"background", "signal"}, hb, hs)
+	if err != nil {
+		log.Panic(err)
+	}
+	stack.HatchStyle = DefaultLineStyle
+	stack.HatchStyle.Width = vg.Points(0.5)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Signal and background"
+	p.Add(stack)
+	names, thumbs := stack.Thumbnailers()
+	for i, name := range names {
+		p.Legend.Add(name, thumbs[i])
+	}
+	p.Legend.Add("uncertainty", stack)
+	p.Legend.Top = true
+
+	err = p.Save(250, 200, "testdata/histogramStack.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestHistogramStack(t *testing.T) {
+	cmpimg.CheckPlot(ExampleHistogramStack, t, "histogramStack.png")
+}
+
+func TestHistogramStackTotals(t *testing.T) {
+	edges := []float64{0, 1, 2}
+	a, err := NewHistogramEdges(unitYs{Values{0.5, 0.5, 1.5, 5}}, edges)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := NewHistogramEdges(unitYs{Values{0.5, 1.5, 1.5, 1.5, 5, 5}}, edges)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, err := NewHistogramStack([]string{"a", "b"}, a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []float64{2, 1} {
+		if got := b.binBase(i); got != want {
+			t.Errorf("unexpected base for bin %d: got:%v want:%v", i, got, want)
+		}
+	}
+	if _, _, ymin, ymax := s.DataRange(); ymin != 0 || ymax != 4 {
+		t.Errorf("unexpected y range: got:[%v, %v] want:[0, 4]", ymin, ymax)
+	}
+
+	b.ShowOverflow = true
+	a.ShowOverflow = true
+	if _, xmax, _, ymax := s.DataRange(); xmax != 3 || ymax != 4 {
+		t.Errorf("unexpected range with overflow: got:xmax=%v ymax=%v want:3, 4", xmax, ymax)
+	}
+
+	s.HatchStyle = DefaultLineStyle
+	if _, _, _, ymax := s.DataRange(); ymax != 4+math.Sqrt(4) {
+		t.Errorf("unexpected y range with uncertainty: got:%v want:%v", ymax, 4+math.Sqrt(4))
+	}
+
+	names, _ := s.Thumbnailers()
+	if len(names) != 2 || names[0] != "b" || names[1] != "a" {
+		t.Errorf("unexpected legend order: got:%v want:[b a]", names)
+	}
+}
+
+func TestHistogramStackIncompatible(t *testing.T) {
+	a, err := NewHistogramEdges(unitYs{Values{0.5}}, []float64{0, 1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, edges := range [][]float64{{0, 1}, {0, 1.5, 2}} {
+		b, err := NewHistogramEdges(unitYs{Values{0.5}}, edges)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := NewHistogramStack([]string{"a", "b"}, a, b); err == nil {
+			t.Errorf("expected error for edges %v", edges)
+		}
+	}
+	if err := a.StackOn(a); err == nil {
+		t.Error("expected error for histogram stacked on itself")
+	}
+
+	// A stack that cannot be made leaves
+	// the histograms unstacked.
+	b, err := NewHistogramEdges(unitYs{Values{0.5}}, []float64{0, 1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c, err := NewHistogramEdges(unitYs{Values{0.5}}, []float64{0, 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := NewHistogramStack([]string{"a", "b", "c"}, a, b, c); err == nil {
+		t.Error("expected error for incompatible top histogram")
+	}
+	if b.stackedOn != nil {
+		t.Error("histogram stacked by failed NewHistogramStack")
+	}
+	if _, err := NewHistogramStack([]string{"a", "b", "a"}, a, b, a); err == nil {
+		t.Error("expected error for repeated histogram")
+	}
+	if b.stackedOn != nil {
+		t.Error("histogram stacked by NewHistogramStack with repeated histogram")
+	}
+}
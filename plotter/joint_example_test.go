@@ -0,0 +1,86 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter_test
+
+import (
+	"image/color"
+	"log"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/plotter"
+)
+
+// Example_jointPlot draws a scatter plot of correlated values
+// with histograms of the X and Y values along its edges, and
+// rugs of the values along its axes.
+func Example_jointPlot() {
+	rnd := rand.New(rand.NewSource(1))
+	xys := make(plotter.XYs, 300)
+	xs := make(plotter.Values, len(xys))
+	ys := make(plotter.Values, len(xys))
+	for i := range xys {
+		x := rnd.NormFloat64()
+		y := 0.6*x + 0.8*rnd.NormFloat64()
+		xys[i].X, xys[i].Y = x, y
+		xs[i], ys[i] = x, y
+	}
+
+	j, err := plot.NewJointPlot()
+	if err != nil {
+		log.Panic(err)
+	}
+	j.TopSize = 0.25
+	j.RightSize = 0.15
+
+	s, err := plotter.NewScatter(xys)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.GlyphStyle.Radius = 1.5
+	xrug, err := plotter.NewRug(xs)
+	if err != nil {
+		log.Panic(err)
+	}
+	yrug, err := plotter.NewRug(ys)
+	if err != nil {
+		log.Panic(err)
+	}
+	yrug.Horizontal = true
+	j.Center.Add(s, xrug, yrug)
+	j.Center.X.Label.Text = "x"
+	j.Center.Y.Label.Text = "y"
+
+	fill := color.RGBA{R: 96, G: 128, B: 192, A: 255}
+	top, err := plotter.NewHist(xs, 20)
+	if err != nil {
+		log.Panic(err)
+	}
+	top.FillColor = fill
+	j.Top.Add(top)
+
+	right, err := plotter.NewHist(ys, 20)
+	if err != nil {
+		log.Panic(err)
+	}
+	right.FillColor = fill
+	right.Horizontal = true
+	j.Right.Add(right)
+	j.Right.X.Tick.Marker = plot.ConstantTicks([]plot.Tick{
+		{Value: 0, Label: "0"},
+		{Value: 30, Label: "30"},
+	})
+
+	err = j.Save(300, 300, "testdata/jointPlot.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestJointPlot(t *testing.T) {
+	cmpimg.CheckPlot(Example_jointPlot, t, "jointPlot.png")
+}
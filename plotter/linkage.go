@@ -0,0 +1,226 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Merge is a step of a hierarchical clustering,
+// joining two clusters into a new one.
+type Merge struct {
+	// A and B are the indices of the joined
+	// clusters. Indices less than the number of
+	// items, n, are the single item clusters of
+	// the items, and the cluster made by the ith
+	// merge has the index n+i.
+	A, B int
+
+	// Distance is the distance between
+	// the joined clusters.
+	Distance float64
+
+	// Size is the number of items
+	// in the new cluster.
+	Size int
+}
+
+// Linkage is a hierarchical clustering of n items, described
+// by the n-1 merges joining them into one cluster, in the order
+// they are made. It has the same form as the linkage matrices
+// of other clustering packages, with a row for each merge.
+type Linkage []Merge
+
+// LinkageMethod specifies how the distance
+// between clusters is calculated.
+type LinkageMethod int
+
+const (
+	// SingleLinkage uses the distance between
+	// the closest items of the clusters.
+	SingleLinkage LinkageMethod = iota
+
+	// CompleteLinkage uses the distance between
+	// the furthest items of the clusters.
+	CompleteLinkage
+
+	// AverageLinkage uses the mean distance
+	// between the items of the clusters.
+	AverageLinkage
+
+	// WardLinkage uses Ward's minimum variance
+	// criterion. The distances between the
+	// items should be Euclidean distances.
+	WardLinkage
+)
+
+// NewLinkage returns the agglomerative hierarchical clustering
+// of the items with the given distance matrix, using the given
+// linkage method. At each step, the two closest clusters are
+// merged. The distance matrix must be square and symmetric, with
+// non-negative distances.
+func NewLinkage(dist [][]float64, method LinkageMethod) (Linkage, error) {
+	n := len(dist)
+	if n < 2 {
+		return nil, errors.New("plotter: too few items to cluster")
+	}
+	if method < SingleLinkage || method > WardLinkage {
+		return nil, fmt.Errorf("plotter: unknown linkage method %d", method)
+	}
+	d := make([][]float64, n)
+	for i, row := range dist {
+		if len(row) != n {
+			return nil, errors.New("plotter: distance matrix is not square")
+		}
+		for j, v := range row {
+			if math.IsNaN(v) || v < 0 {
+				return nil, fmt.Errorf("plotter: invalid distance %v", v)
+			}
+			if v != dist[j][i] {
+				return nil, errors.New("plotter: distance matrix is not symmetric")
+			}
+		}
+		d[i] = append([]float64(nil), row...)
+	}
+
+	// Row i of d holds the distances of the cluster
+	// with the index id[i], while active[i] is true.
+	id := make([]int, n)
+	size := make([]int, n)
+	active := make([]bool, n)
+	for i := range id {
+		id[i] = i
+		size[i] = 1
+		active[i] = true
+	}
+	link := make(Linkage, 0, n-1)
+	for step := 0; step < n-1; step++ {
+		a, b := -1, -1
+		for i := range d {
+			if !active[i] {
+				continue
+			}
+			for j := i + 1; j < n; j++ {
+				if active[j] && (a < 0 || d[i][j] < d[a][b]) {
+					a, b = i, j
+				}
+			}
+		}
+
+		m := Merge{A: id[a], B: id[b], Distance: d[a][b], Size: size[a] + size[b]}
+		if m.A > m.B {
+			m.A, m.B = m.B, m.A
+		}
+		link = append(link, m)
+
+		// Replace cluster a by the merged cluster,
+		// using the Lance–Williams update.
+		for k := range d {
+			if !active[k] || k == a || k == b {
+				continue
+			}
+			na, nb, nk := float64(size[a]), float64(size[b]), float64(size[k])
+			dak, dbk, dab := d[a][k], d[b][k], d[a][b]
+			var v float64
+			switch method {
+			case SingleLinkage:
+				v = math.Min(dak, dbk)
+			case CompleteLinkage:
+				v = math.Max(dak, dbk)
+			case AverageLinkage:
+				v = (na*dak + nb*dbk) / (na + nb)
+			case WardLinkage:
+				v = math.Sqrt(((na+nk)*dak*dak + (nb+nk)*dbk*dbk - nk*dab*dab) / (na + nb + nk))
+			}
+			d[a][k], d[k][a] = v, v
+		}
+		id[a] = n + step
+		size[a] = m.Size
+		active[b] = false
+	}
+	return link, nil
+}
+
+// Leaves returns the indices of the clustered
+// items in the order they are drawn in a
+// dendrogram of the clustering. An error is
+// returned if the clustering is not valid.
+func (l Linkage) Leaves() ([]int, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+	n := len(l) + 1
+	leaves := make([]int, 0, n)
+	var walk func(c int)
+	walk = func(c int) {
+		if c < n {
+			leaves = append(leaves, c)
+			return
+		}
+		m := l[c-n]
+		walk(m.A)
+		walk(m.B)
+	}
+	walk(2*n - 2)
+	return leaves, nil
+}
+
+// Clusters returns the cluster of each item when the
+// clustering is cut at the given distance, so that the
+// items joined by merges at distances less than cut are
+// in the same cluster. The clusters are numbered from
+// zero in the order of the leaves of the clustering.
+// An error is returned if the clustering is not valid.
+func (l Linkage) Clusters(cut float64) ([]int, error) {
+	if err := l.validate(); err != nil {
+		return nil, err
+	}
+	n := len(l) + 1
+	clusters := make([]int, n)
+	next := 0
+	var walk func(c, cluster int)
+	walk = func(c, cluster int) {
+		if cluster < 0 && (c < n || l[c-n].Distance < cut) {
+			cluster = next
+			next++
+		}
+		if c < n {
+			clusters[c] = cluster
+			return
+		}
+		m := l[c-n]
+		walk(m.A, cluster)
+		walk(m.B, cluster)
+	}
+	walk(2*n-2, -1)
+	return clusters, nil
+}
+
+// validate returns an error if l is
+// not a valid hierarchical clustering.
+func (l Linkage) validate() error {
+	if len(l) == 0 {
+		return ErrNoData
+	}
+	n := len(l) + 1
+	merged := make([]bool, 2*n-1)
+	for i, m := range l {
+		for _, c := range []int{m.A, m.B} {
+			if c < 0 || c >= n+i {
+				return fmt.Errorf("plotter: merge %d joins unknown cluster %d", i, c)
+			}
+			if merged[c] {
+				return fmt.Errorf("plotter: cluster %d is merged twice", c)
+			}
+			merged[c] = true
+		}
+		if math.IsNaN(m.Distance) || m.Distance < 0 {
+			return fmt.Errorf("plotter: invalid merge distance %v", m.Distance)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// lineDistances returns the distance matrix
+// of points on a line at the given positions.
+func lineDistances(x ...float64) [][]float64 {
+	d := make([][]float64, len(x))
+	for i := range d {
+		d[i] = make([]float64, len(x))
+		for j := range d[i] {
+			d[i][j] = math.Abs(x[i] - x[j])
+		}
+	}
+	return d
+}
+
+func TestNewLinkage(t *testing.T) {
+	dist := lineDistances(0, 1, 3, 7)
+	for _, test := range []struct {
+		method LinkageMethod
+		want   Linkage
+	}{
+		{
+			method: SingleLinkage,
+			want:   Linkage{{A: 0, B: 1, Distance: 1, Size: 2}, {A: 2, B: 4, Distance: 2, Size: 3}, {A: 3, B: 5, Distance: 4, Size: 4}},
+		},
+		{
+			method: CompleteLinkage,
+			want:   Linkage{{A: 0, B: 1, Distance: 1, Size: 2}, {A: 2, B: 4, Distance: 3, Size: 3}, {A: 3, B: 5, Distance: 7, Size: 4}},
+		},
+		{
+			method: AverageLinkage,
+			want:   Linkage{{A: 0, B: 1, Distance: 1, Size: 2}, {A: 2, B: 4, Distance: 2.5, Size: 3}, {A: 3, B: 5, Distance: 17.0 / 3, Size: 4}},
+		},
+		{
+			// Ward distances are sqrt(2*na*nb/(na+nb)) times
+			// the distance between the cluster centroids.
+			method: WardLinkage,
+			want: Linkage{
+				{A: 0, B: 1, Distance: 1, Size: 2},
+				{A: 2, B: 4, Distance: math.Sqrt(4.0/3) * 2.5, Size: 3},
+				{A: 3, B: 5, Distance: math.Sqrt(1.5) * 17 / 3, Size: 4},
+			},
+		},
+	} {
+		got, err := NewLinkage(dist, test.method)
+		if err != nil {
+			t.Fatalf("unexpected error for method %d: %v", test.method, err)
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("unexpected number of merges for method %d: got:%d want:%d", test.method, len(got), len(test.want))
+		}
+		for i, m := range got {
+			w := test.want[i]
+			if m.A != w.A || m.B != w.B || m.Size != w.Size || math.Abs(m.Distance-w.Distance) > 1e-12 {
+				t.Errorf("unexpected merge %d for method %d: got:%+v want:%+v", i, test.method, m, w)
+			}
+		}
+	}
+}
+
+func TestNewLinkageErrors(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		dist   [][]float64
+		method LinkageMethod
+	}{
+		{name: "one item", dist: lineDistances(0)},
+		{name: "not square", dist: [][]float64{{0, 1}, {1}}},
+		{name: "not symmetric", dist: [][]float64{{0, 1}, {2, 0}}},
+		{name: "negative", dist: [][]float64{{0, -1}, {-1, 0}}},
+		{name: "method", dist: lineDistances(0, 1), method: WardLinkage + 1},
+	} {
+		if _, err := NewLinkage(test.dist, test.method); err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+}
+
+func TestLinkageLeavesClusters(t *testing.T) {
+	l, err := NewLinkage(lineDistances(0, 1, 3, 7), SingleLinkage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	leaves, err := l.Leaves()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []int{3, 2, 0, 1}; !reflect.DeepEqual(leaves, want) {
+		t.Errorf("unexpected leaves: got:%v want:%v", leaves, want)
+	}
+	for _, test := range []struct {
+		cut  float64
+		want []int
+	}{
+		{cut: 0, want: []int{2, 3, 1, 0}},
+		{cut: 1.5, want: []int{2, 2, 1, 0}},
+		{cut: 2.5, want: []int{1, 1, 1, 0}},
+		{cut: 5, want: []int{0, 0, 0, 0}},
+	} {
+		got, err := l.Clusters(test.cut)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected clusters for cut %v: got:%v want:%v", test.cut, got, test.want)
+		}
+	}
+
+	// A clustering built by hand is validated
+	// rather than indexed out of range.
+	bad := Linkage{{A: 0, B: 1, Distance: 1}, {A: 0, B: 5, Distance: 2}}
+	if _, err := bad.Leaves(); err == nil {
+		t.Errorf("expected error for leaves of invalid clustering")
+	}
+	if _, err := bad.Clusters(1.5); err == nil {
+		t.Errorf("expected error for clusters of invalid clustering")
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Records wraps the Dims and At methods of a table of
+// values with a row for each record and a column for
+// each dimension. A *mat.Dense from the gonum mat
+// package implements Records.
+type Records interface {
+	// Dims returns the number of records
+	// and dimensions of the table.
+	Dims() (r, c int)
+
+	// At returns the value of dimension
+	// j of record i.
+	At(i, j int) float64
+}
+
+// ParallelAxis is the axis of a dimension of
+// a ParallelCoordinates plotter.
+type ParallelAxis struct {
+	// Label is the text drawn below the axis.
+	Label string
+
+	// Min and Max are the values at the bottom
+	// and top of the axis. If Min is greater than
+	// Max, the axis is inverted.
+	Min, Max float64
+
+	// Scale transforms the values of the dimension
+	// to their distance along the axis, as for
+	// plot.Axis.
+	Scale plot.Normalizer
+
+	// Ticker returns the tick marks of the axis.
+	// If Ticker is nil, no tick marks are drawn.
+	Ticker plot.Ticker
+}
+
+// norm returns the value x normalized
+// to its distance along the axis.
+func (a *ParallelAxis) norm(x float64) float64 {
+	return a.Scale.Normalize(a.Min, a.Max, x)
+}
+
+// ticks returns the tick marks of the axis.
+func (a *ParallelAxis) ticks() []plot.Tick {
+	if a.Ticker == nil {
+		return nil
+	}
+	return a.Ticker.Ticks(math.Min(a.Min, a.Max), math.Max(a.Min, a.Max))
+}
+
+// ParallelCoordinates implements the Plotter interface,
+// drawing each record of a multivariate data set as a
+// line across a vertical axis for each dimension.
+//
+// The axes of the dimensions are placed at X values
+// 0, 1, …, n-1 and span Y values from 0 to 1. The
+// plotter draws the axes of the dimensions itself,
+// so the axes of the plot are usually hidden.
+type ParallelCoordinates struct {
+	// Data holds the values of the records,
+	// indexed by record and then dimension.
+	Data [][]float64
+
+	// Axes holds the axis of each dimension.
+	Axes []ParallelAxis
+
+	// LineStyle is the style of the record lines.
+	draw.LineStyle
+
+	// ColorMap, if not nil, is used to color each
+	// record line by its value of the dimension
+	// ColorBy. Lines with values outside the range
+	// of the ColorMap use the LineStyle color, as do
+	// all the lines if ColorBy is not the index of a
+	// dimension.
+	ColorMap palette.ColorMap
+	ColorBy  int
+
+	// AxisStyle is the style of the axis lines.
+	AxisStyle draw.LineStyle
+
+	// TickStyle is the style of the tick marks,
+	// and TickLength is the length of a major
+	// tick mark. Minor tick marks are half
+	// the length of major tick marks.
+	TickStyle  draw.LineStyle
+	TickLength vg.Length
+
+	// TickLabelStyle is the style of the
+	// tick labels, drawn left of the axes.
+	TickLabelStyle draw.TextStyle
+
+	// LabelStyle is the style of the axis
+	// labels, drawn below the axes.
+	LabelStyle draw.TextStyle
+}
+
+// NewParallelCoordinates returns a ParallelCoordinates plotter
+// for the given records. The range of each axis is set to the
+// range of its dimension, with a linear scale and the default
+// ticks. An error is returned if there are no records, fewer
+// than two dimensions, or if a value is NaN or infinite.
+func NewParallelCoordinates(data Records) (*ParallelCoordinates, error) {
+	r, c := data.Dims()
+	if r == 0 {
+		return nil, ErrNoData
+	}
+	if c < 2 {
+		return nil, errors.New("plotter: parallel coordinates need at least two dimensions")
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([][]float64, r)
+	axes := make([]ParallelAxis, c)
+	for j := range axes {
+		axes[j] = ParallelAxis{
+			Min:    math.Inf(1),
+			Max:    math.Inf(-1),
+			Scale:  plot.LinearScale{},
+			Ticker: plot.DefaultTicks{},
+		}
+	}
+	for i := range vals {
+		vals[i] = make([]float64, c)
+		for j := range vals[i] {
+			v := data.At(i, j)
+			if err := CheckFloats(v); err != nil {
+				return nil, err
+			}
+			vals[i][j] = v
+			axes[j].Min = math.Min(axes[j].Min, v)
+			axes[j].Max = math.Max(axes[j].Max, v)
+		}
+	}
+	for j := range axes {
+		if axes[j].Min == axes[j].Max {
+			axes[j].Min--
+			axes[j].Max++
+		}
+	}
+
+	return &ParallelCoordinates{
+		Data:       vals,
+		Axes:       axes,
+		LineStyle:  DefaultLineStyle,
+		AxisStyle:  DefaultLineStyle,
+		TickStyle:  DefaultLineStyle,
+		TickLength: vg.Points(4),
+		TickLabelStyle: draw.TextStyle{
+			Color:  color.Black,
+			Font:   fnt,
+			XAlign: draw.XRight,
+			YAlign: draw.YCenter,
+		},
+		LabelStyle: draw.TextStyle{
+			Color:  color.Black,
+			Font:   fnt,
+			XAlign: draw.XCenter,
+			YAlign: draw.YTop,
+		},
+	}, nil
+}
+
+// parallelGap is the space between the axes
+// of a ParallelCoordinates and their labels.
+const parallelGap = vg.Length(2)
+
+// Plot implements the Plotter interface, drawing the record
+// lines and then the axes of the dimensions over them.
+func (pc *ParallelCoordinates) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	colorBy := pc.ColorMap != nil && pc.ColorBy >= 0 && pc.ColorBy < len(pc.Axes)
+	for _, rec := range pc.Data {
+		line := make([]vg.Point, len(rec))
+		for j, v := range rec {
+			line[j] = vg.Point{X: trX(float64(j)), Y: trY(pc.Axes[j].norm(v))}
+		}
+		sty := pc.LineStyle
+		if colorBy {
+			col, err := pc.ColorMap.At(rec[pc.ColorBy])
+			if err == nil {
+				sty.Color = col
+			}
+		}
+		c.StrokeLines(sty, c.ClipLinesXY(line)...)
+	}
+
+	for j := range pc.Axes {
+		a := &pc.Axes[j]
+		x := trX(float64(j))
+		c.StrokeLine2(pc.AxisStyle, x, trY(0), x, trY(1))
+		for _, t := range a.ticks() {
+			n := a.norm(t.Value)
+			if n < 0 || n > 1 {
+				continue
+			}
+			y := trY(n)
+			l := pc.TickLength
+			if t.IsMinor() {
+				l /= 2
+			}
+			c.StrokeLine2(pc.TickStyle, x-l, y, x, y)
+			if !t.IsMinor() {
+				c.FillText(pc.TickLabelStyle, vg.Point{X: x - pc.TickLength - parallelGap, Y: y}, t.Label)
+			}
+		}
+		if a.Label != "" {
+			c.FillText(pc.LabelStyle, vg.Point{X: x, Y: trY(0) - parallelGap}, a.Label)
+		}
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (pc *ParallelCoordinates) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return 0, float64(len(pc.Axes) - 1), 0, 1
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface,
+// making room for the tick labels and axis labels.
+func (pc *ParallelCoordinates) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	for j := range pc.Axes {
+		a := &pc.Axes[j]
+		x := plt.X.Norm(float64(j))
+		for _, t := range a.ticks() {
+			n := a.norm(t.Value)
+			if n < 0 || n > 1 || t.IsMinor() {
+				continue
+			}
+			w := pc.TickLabelStyle.Width(t.Label)
+			h := pc.TickLabelStyle.Height(t.Label)
+			boxes = append(boxes, plot.GlyphBox{
+				X: x,
+				Y: plt.Y.Norm(n),
+				Rectangle: vg.Rectangle{
+					Min: vg.Point{X: -pc.TickLength - parallelGap - w, Y: -h / 2},
+					Max: vg.Point{X: 0, Y: h / 2},
+				},
+			})
+		}
+		if a.Label != "" {
+			w := pc.LabelStyle.Width(a.Label)
+			h := pc.LabelStyle.Height(a.Label)
+			boxes = append(boxes, plot.GlyphBox{
+				X: x,
+				Y: plt.Y.Norm(0),
+				Rectangle: vg.Rectangle{
+					Min: vg.Point{X: -w / 2, Y: -parallelGap - h},
+					Max: vg.Point{X: w / 2, Y: 0},
+				},
+			})
+		}
+	}
+	return boxes
+}
+
+// Thumbnail implements the plot.Thumbnailer interface.
+func (pc *ParallelCoordinates) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(pc.LineStyle, c.Min.X, y, c.Max.X, y)
+}
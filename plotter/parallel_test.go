@@ -0,0 +1,138 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// ExampleParallelCoordinates draws the parameters and
+// results of a parameter sweep, colored by the result.
+func ExampleParallelCoordinates() {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 50
+	data := mat.NewDense(n, 4, nil)
+	for i := 0; i < n; i++ {
+		rate := math.Pow(10, -4+3*rnd.Float64())
+		batch := float64(int(16 << uint(rnd.Intn(4))))
+		depth := float64(2 + rnd.Intn(6))
+		loss := 0.2 + 0.1*math.Abs(math.Log10(rate)+2.5) + 0.02*depth + 0.05*rnd.Float64()
+		data.SetRow(i, []float64{rate, batch, depth, loss})
+	}
+
+	pc, err := NewParallelCoordinates(data)
+	if err != nil {
+		log.Panic(err)
+	}
+	pc.Axes[0].Label = "rate"
+	pc.Axes[0].Scale = plot.LogScale{}
+	pc.Axes[0].Ticker = plot.LogTicks{}
+	pc.Axes[1].Label = "batch"
+	pc.Axes[2].Label = "depth"
+	pc.Axes[3].Label = "loss"
+
+	// Color the lines by the loss, with the
+	// lowest loss at the top of its axis.
+	pc.Axes[3].Min, pc.Axes[3].Max = pc.Axes[3].Max, pc.Axes[3].Min
+	cm := moreland.SmoothBlueRed()
+	cm.SetMin(pc.Axes[3].Max)
+	cm.SetMax(pc.Axes[3].Min)
+	pc.ColorMap = cm
+	pc.ColorBy = 3
+	pc.Width = vg.Points(0.75)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Parameter sweep"
+	p.Add(pc)
+	p.HideAxes()
+
+	err = p.Save(300, 200, "testdata/parallelCoordinates.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestParallelCoordinates(t *testing.T) {
+	cmpimg.CheckPlot(ExampleParallelCoordinates, t, "parallelCoordinates.png")
+}
+
+func TestNewParallelCoordinates(t *testing.T) {
+	pc, err := NewParallelCoordinates(mat.NewDense(3, 3, []float64{
+		1, 5, 2,
+		2, 5, 4,
+		3, 5, 8,
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for j, want := range [][2]float64{{1, 3}, {4, 6}, {2, 8}} {
+		a := pc.Axes[j]
+		if a.Min != want[0] || a.Max != want[1] {
+			t.Errorf("unexpected range of axis %d: got:[%v, %v] want:%v", j, a.Min, a.Max, want)
+		}
+	}
+	if got := pc.Axes[2].norm(4); got != 1.0/3 {
+		t.Errorf("unexpected normalized value: got:%v want:%v", got, 1.0/3)
+	}
+	pc.Axes[2].Min, pc.Axes[2].Max = pc.Axes[2].Max, pc.Axes[2].Min
+	if got := pc.Axes[2].norm(4); got != 2.0/3 {
+		t.Errorf("unexpected normalized value on inverted axis: got:%v want:%v", got, 2.0/3)
+	}
+	xmin, xmax, ymin, ymax := pc.DataRange()
+	if xmin != 0 || xmax != 2 || ymin != 0 || ymax != 1 {
+		t.Errorf("unexpected data range: got:[%v, %v]×[%v, %v] want:[0, 2]×[0, 1]", xmin, xmax, ymin, ymax)
+	}
+
+	for _, data := range []Records{
+		mat.NewDense(2, 1, []float64{1, 2}),
+		mat.NewDense(1, 2, []float64{1, math.NaN()}),
+	} {
+		if _, err := NewParallelCoordinates(data); err == nil {
+			t.Errorf("expected error for data %v", mat.Formatted(data.(mat.Matrix)))
+		}
+	}
+	// A ColorBy that is not a dimension leaves the
+	// lines in the LineStyle color.
+	pc.ColorMap = moreland.SmoothBlueRed()
+	pc.ColorMap.SetMin(1)
+	pc.ColorMap.SetMax(3)
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(pc)
+	for _, colorBy := range []int{-1, 3} {
+		pc.ColorBy = colorBy
+		var rec recorder.Canvas
+		pc.Plot(draw.NewCanvas(&rec, 100, 100), p)
+		for _, a := range rec.Actions {
+			if a, ok := a.(*recorder.SetColor); ok && !sameColor(a.Color, pc.LineStyle.Color) && !sameColor(a.Color, pc.AxisStyle.Color) {
+				t.Errorf("unexpected line color for ColorBy %d: got:%v", colorBy, a.Color)
+				break
+			}
+		}
+	}
+}
+
+func sameColor(a, b color.Color) bool {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	return ar == br && ag == bg && ab == bb && aa == ba
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Parametric implements the Plotter interface, drawing
+// the curve (X(t), Y(t)) for t from TMin to TMax.
+//
+// The curve is broken where either function
+// returns NaN or ±Inf.
+type Parametric struct {
+	X, Y       func(t float64) float64
+	TMin, TMax float64
+	Samples    int
+
+	// Tolerance and MaxDepth control the adaptive
+	// sampling of the curve, as for Function.
+	// NewParametric sets Tolerance to
+	// DefaultTolerance.
+	Tolerance vg.Length
+	MaxDepth  int
+
+	draw.LineStyle
+}
+
+// NewParametric returns a Parametric that plots the curve
+// (x(t), y(t)) for t from tmin to tmax, using the default
+// line style with 100 samples, refined adaptively
+// to within DefaultTolerance.
+func NewParametric(x, y func(t float64) float64, tmin, tmax float64) *Parametric {
+	return &Parametric{
+		X:         x,
+		Y:         y,
+		TMin:      tmin,
+		TMax:      tmax,
+		Samples:   100,
+		Tolerance: DefaultTolerance,
+		MaxDepth:  DefaultMaxDepth,
+		LineStyle: DefaultLineStyle,
+	}
+}
+
+// Plot implements the Plotter interface,
+// drawing a line along the curve.
+func (f *Parametric) Plot(c draw.Canvas, p *plot.Plot) {
+	trX, trY := p.Transforms(&c)
+	s := curveSampler{
+		f:        f.at,
+		trX:      trX,
+		trY:      trY,
+		tol:      f.Tolerance,
+		maxDepth: f.MaxDepth,
+	}
+	for _, line := range s.sample(f.TMin, f.TMax, f.Samples) {
+		c.StrokeLines(f.LineStyle, c.ClipLinesXY(line)...)
+	}
+}
+
+// at returns the point of the curve at t.
+func (f *Parametric) at(t float64) (x, y float64) {
+	return f.X(t), f.Y(t)
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The range is that of the finite points of the curve
+// at the Samples uniform samples of t.
+func (f *Parametric) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, ymin = math.Inf(1), math.Inf(1)
+	xmax, ymax = math.Inf(-1), math.Inf(-1)
+	n := f.Samples
+	if n < 2 {
+		n = 2
+	}
+	d := (f.TMax - f.TMin) / float64(n-1)
+	for i := 0; i < n; i++ {
+		x, y := f.at(f.TMin + float64(i)*d)
+		if !isFinite(x) || !isFinite(y) {
+			continue
+		}
+		xmin = math.Min(xmin, x)
+		xmax = math.Max(xmax, x)
+		ymin = math.Min(ymin, y)
+		ymax = math.Max(ymax, y)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// Thumbnail draws a line in the given style down the
+// center of a DrawArea as a thumbnail representation
+// of the LineStyle of the curve.
+func (f *Parametric) Thumbnail(c *draw.Canvas) {
+	y := c.Center().Y
+	c.StrokeLine2(f.LineStyle, c.Min.X, y, c.Max.X, y)
+}
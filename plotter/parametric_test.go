@@ -0,0 +1,75 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleParametric draws a Lissajous curve and a spiral.
+func ExampleParametric() {
+	lissajous := NewParametric(
+		func(t float64) float64 { return math.Sin(3 * t) },
+		func(t float64) float64 { return math.Sin(4 * t) },
+		0, 2*math.Pi,
+	)
+	lissajous.Tolerance = vg.Points(0.25)
+	lissajous.Color = color.RGBA{B: 255, A: 255}
+
+	spiral := NewParametric(
+		func(t float64) float64 { return t * math.Cos(t) / 10 },
+		func(t float64) float64 { return t * math.Sin(t) / 10 },
+		0, 6*math.Pi,
+	)
+	spiral.Tolerance = vg.Points(0.25)
+	spiral.Color = color.RGBA{R: 255, A: 255}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Parametric curves"
+	p.Add(lissajous, spiral)
+	p.Legend.Add("Lissajous", lissajous)
+	p.Legend.Add("spiral", spiral)
+
+	err = p.Save(200, 200, "testdata/parametric.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestParametric(t *testing.T) {
+	cmpimg.CheckPlot(ExampleParametric, t, "parametric.png")
+}
+
+func TestParametricDataRange(t *testing.T) {
+	circle := NewParametric(math.Cos, math.Sin, 0, 2*math.Pi)
+	circle.Samples = 5
+	xmin, xmax, ymin, ymax := circle.DataRange()
+	const tol = 1e-12
+	if math.Abs(xmin+1) > tol || math.Abs(xmax-1) > tol || math.Abs(ymin+1) > tol || math.Abs(ymax-1) > tol {
+		t.Errorf("unexpected range: got:[%v, %v]×[%v, %v] want:[-1, 1]×[-1, 1]", xmin, xmax, ymin, ymax)
+	}
+
+	// Non-finite points do not contribute to the range.
+	hyperbola := NewParametric(
+		func(t float64) float64 { return t },
+		func(t float64) float64 { return 1 / t },
+		-1, 1,
+	)
+	hyperbola.Samples = 5
+	_, _, ymin, ymax = hyperbola.DataRange()
+	if ymin != -2 || ymax != 2 {
+		t.Errorf("unexpected y range: got:[%v, %v] want:[-2, 2]", ymin, ymax)
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// QuantileMethod is a method of estimating the quantiles of
+// a sample, from the continuous family of Hyndman and Fan.
+// The kth of the n sorted values of a sample is taken to be
+// the quantile at the plotting position (k-Alpha)/(n+1-Alpha-Beta),
+// and quantiles between the plotting positions are linearly
+// interpolated.
+type QuantileMethod struct {
+	Alpha, Beta float64
+}
+
+var (
+	// QuantileR4 is the method of R type 4,
+	// with plotting positions k/n.
+	QuantileR4 = QuantileMethod{Alpha: 0, Beta: 1}
+
+	// QuantileR5 is the method of R type 5, with
+	// plotting positions (k-1/2)/n, as proposed
+	// by Hazen.
+	QuantileR5 = QuantileMethod{Alpha: 0.5, Beta: 0.5}
+
+	// QuantileR6 is the method of R type 6, with
+	// plotting positions k/(n+1), as proposed by
+	// Weibull.
+	QuantileR6 = QuantileMethod{Alpha: 0, Beta: 0}
+
+	// QuantileR7 is the method of R type 7, with
+	// plotting positions (k-1)/(n-1). It is the
+	// default method of R and of NumPy.
+	QuantileR7 = QuantileMethod{Alpha: 1, Beta: 1}
+
+	// QuantileR8 is the method of R type 8, giving
+	// approximately median-unbiased quantiles for
+	// any distribution.
+	QuantileR8 = QuantileMethod{Alpha: 1.0 / 3, Beta: 1.0 / 3}
+
+	// QuantileR9 is the method of R type 9, with
+	// the plotting positions of Blom, giving
+	// approximately unbiased quantiles for
+	// normally distributed data.
+	QuantileR9 = QuantileMethod{Alpha: 3.0 / 8, Beta: 3.0 / 8}
+)
+
+// Quantile returns the pth quantile of the values in
+// x, which must be sorted in increasing order.
+func (m QuantileMethod) Quantile(x []float64, p float64) float64 {
+	// h is the one-based position of the quantile.
+	h := (float64(len(x))+1-m.Alpha-m.Beta)*p + m.Alpha
+	switch {
+	case h <= 1:
+		return x[0]
+	case h >= float64(len(x)):
+		return x[len(x)-1]
+	}
+	i := int(h)
+	return x[i-1] + (h-math.Floor(h))*(x[i]-x[i-1])
+}
+
+// position returns the plotting position of the
+// kth of n sorted values, starting from one.
+func (m QuantileMethod) position(k, n int) float64 {
+	return (float64(k) - m.Alpha) / (float64(n) + 1 - m.Alpha - m.Beta)
+}
+
+// Quantiler wraps the Quantile method of a probability
+// distribution, such as the distributions of the
+// gonum.org/v1/gonum/stat/distuv package.
+type Quantiler interface {
+	// Quantile returns the value below which the
+	// given fraction of the distribution lies.
+	Quantile(p float64) float64
+}
+
+// QQ implements the Plotter interface, drawing a quantile-quantile
+// plot: the quantiles of a sample against the quantiles of another
+// sample or of a theoretical distribution, drawn as a Scatter. The
+// points lie close to a straight line when the distributions have
+// the same shape.
+type QQ struct {
+	// Scatter draws the pairs of quantiles, with the
+	// reference quantiles on the X axis and the sample
+	// quantiles on the Y axis.
+	*Scatter
+
+	// Intercept and Slope describe the reference line,
+	// y = Intercept + Slope×x. The constructors set them
+	// so the line passes through the first and third
+	// quartiles of the two distributions.
+	Intercept, Slope float64
+
+	// LineStyle is the style of the reference line.
+	// If the width is zero, the line is not drawn.
+	LineStyle draw.LineStyle
+
+	// Confidence is the confidence level of the band
+	// about the reference line within which the points
+	// lie for samples from the reference distribution.
+	// If Confidence is zero, the band is not drawn.
+	// The band is only available against a theoretical
+	// distribution.
+	Confidence float64
+
+	// BandColor is the fill color of the confidence band.
+	BandColor color.Color
+
+	// dist is the theoretical reference distribution,
+	// and positions holds the plotting positions of the
+	// sample values.
+	dist      Quantiler
+	positions []float64
+}
+
+// NewQQ returns a QQ plotter of the quantiles of the sample
+// against those of the distribution dist, using the plotting
+// positions of the given quantile method. An error is returned
+// if a sample value is not finite, or if dist has an infinite
+// quantile at a plotting position, as for methods with plotting
+// positions of zero or one.
+func NewQQ(sample Valuer, dist Quantiler, m QuantileMethod) (*QQ, error) {
+	ys, err := sortedValues(sample)
+	if err != nil {
+		return nil, err
+	}
+	n := len(ys)
+	xys := make(XYs, n)
+	pos := make([]float64, n)
+	for k, y := range ys {
+		pos[k] = m.position(k+1, n)
+		x := dist.Quantile(pos[k])
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, errors.New("plotter: theoretical quantile at plotting position is not finite")
+		}
+		xys[k].X = x
+		xys[k].Y = y
+	}
+	q := newQQ(xys)
+	q.dist = dist
+	q.positions = pos
+	q.Slope = (m.Quantile(ys, 0.75) - m.Quantile(ys, 0.25)) / (dist.Quantile(0.75) - dist.Quantile(0.25))
+	q.Intercept = m.Quantile(ys, 0.25) - q.Slope*dist.Quantile(0.25)
+	return q, nil
+}
+
+// NewNormalQQ returns a normal probability plot of the sample,
+// a QQ plotter against the standard normal distribution using
+// the plotting positions of Blom, QuantileR9. Marking the X axis
+// with NormalProbabilityTicks labels the normal quantiles with
+// their cumulative probabilities, as on normal probability paper.
+func NewNormalQQ(sample Valuer) (*QQ, error) {
+	return NewQQ(sample, stdNormal{}, QuantileR9)
+}
+
+// stdNormal is the Quantiler of the standard
+// normal distribution.
+type stdNormal struct{}
+
+// Quantile returns the quantile of the standard normal
+// distribution at p, by the rational approximation of
+// Acklam refined by a step of Halley's method, which
+// is accurate to nearly full float64 precision.
+func (stdNormal) Quantile(p float64) float64 {
+	switch {
+	case math.IsNaN(p) || p < 0 || p > 1:
+		return math.NaN()
+	case p == 0:
+		return math.Inf(-1)
+	case p > 0.5:
+		// 1-p is exact for p in (0.5, 1].
+		return -stdNormal{}.Quantile(1 - p)
+	}
+	var x float64
+	const pLow = 0.02425
+	if p < pLow {
+		const (
+			c0, c1, c2, c3, c4, c5 = -7.784894002430293e-03, -3.223964580411365e-01, -2.400758277161838e+00, -2.549732539343734e+00, 4.374664141464968e+00, 2.938163982698783e+00
+			d0, d1, d2, d3         = 7.784695709041462e-03, 3.224671290700398e-01, 2.445134137142996e+00, 3.754408661907416e+00
+		)
+		q := math.Sqrt(-2 * math.Log(p))
+		x = (((((c0*q+c1)*q+c2)*q+c3)*q+c4)*q + c5) / ((((d0*q+d1)*q+d2)*q+d3)*q + 1)
+	} else {
+		const (
+			a0, a1, a2, a3, a4, a5 = -3.969683028665376e+01, 2.209460984245205e+02, -2.759285104469687e+02, 1.383577518672690e+02, -3.066479806614716e+01, 2.506628277459239e+00
+			b0, b1, b2, b3, b4     = -5.447609879822406e+01, 1.615858368580409e+02, -1.556989798598866e+02, 6.680131188771972e+01, -1.328068155288572e+01
+		)
+		q := p - 0.5
+		r := q * q
+		x = (((((a0*r+a1)*r+a2)*r+a3)*r+a4)*r + a5) * q / (((((b0*r+b1)*r+b2)*r+b3)*r+b4)*r + 1)
+	}
+
+	// Refine x by a step of Halley's method on the
+	// cumulative distribution function.
+	e := 0.5*math.Erfc(-x/math.Sqrt2) - p
+	u := e * math.Sqrt(2*math.Pi) * math.Exp(x*x/2)
+	return x - u/(1+x*u/2)
+}
+
+// NewQQSamples returns a QQ plotter of the quantiles of the
+// sample y against those of the sample x. The values of the
+// smaller sample are plotted against the quantiles of the
+// larger sample at their plotting positions, calculated with
+// the given quantile method.
+func NewQQSamples(x, y Valuer, m QuantileMethod) (*QQ, error) {
+	xs, err := sortedValues(x)
+	if err != nil {
+		return nil, err
+	}
+	ys, err := sortedValues(y)
+	if err != nil {
+		return nil, err
+	}
+	n := len(xs)
+	if len(ys) < n {
+		n = len(ys)
+	}
+	xys := make(XYs, n)
+	for k := range xys {
+		p := m.position(k+1, n)
+		xys[k].X = m.Quantile(xs, p)
+		if len(xs) == n {
+			xys[k].X = xs[k]
+		}
+		xys[k].Y = m.Quantile(ys, p)
+		if len(ys) == n {
+			xys[k].Y = ys[k]
+		}
+	}
+	q := newQQ(xys)
+	q.Slope = (m.Quantile(ys, 0.75) - m.Quantile(ys, 0.25)) / (m.Quantile(xs, 0.75) - m.Quantile(xs, 0.25))
+	q.Intercept = m.Quantile(ys, 0.25) - q.Slope*m.Quantile(xs, 0.25)
+	return q, nil
+}
+
+// newQQ returns a QQ plotter of the given pairs of
+// quantiles with the default styles.
+func newQQ(xys XYs) *QQ {
+	return &QQ{
+		Scatter:   &Scatter{XYs: xys, GlyphStyle: DefaultGlyphStyle},
+		LineStyle: DefaultLineStyle,
+		BandColor: color.Gray{Y: 220},
+	}
+}
+
+// sortedValues returns a sorted copy of the values of vs.
+func sortedValues(vs Valuer) ([]float64, error) {
+	v, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	sort.Float64s(v)
+	return v, nil
+}
+
+// Band returns the lower and upper edges of the confidence band
+// at each point, or nil if the band is not available. The band is
+// the simultaneous band of the Dvoretzky–Kiefer–Wolfowitz inequality:
+// the empirical distribution of a sample of n values lies within
+// ε = sqrt(ln(2/(1-Confidence))/2n) of the true distribution, so
+// the kth point lies between the reference line at the quantiles
+// of the plotting positions shifted by ±ε.
+func (q *QQ) Band() (lower, upper []float64) {
+	if q.dist == nil || q.Confidence <= 0 || q.Confidence >= 1 {
+		return nil, nil
+	}
+	n := len(q.positions)
+	eps := math.Sqrt(math.Log(2/(1-q.Confidence)) / (2 * float64(n)))
+	lower = make([]float64, n)
+	upper = make([]float64, n)
+	for k, p := range q.positions {
+		lower[k] = q.Intercept + q.Slope*q.quantile(p-eps)
+		upper[k] = q.Intercept + q.Slope*q.quantile(p+eps)
+		if lower[k] > upper[k] {
+			lower[k], upper[k] = upper[k], lower[k]
+		}
+	}
+	return lower, upper
+}
+
+// quantile returns the quantile of the reference distribution at
+// p, limited to the finite quantiles so the band can be drawn.
+func (q *QQ) quantile(p float64) float64 {
+	const tiny = 1e-12
+	return q.dist.Quantile(math.Max(tiny, math.Min(1-tiny, p)))
+}
+
+// Plot implements the Plotter interface, drawing the
+// confidence band, the reference line and the points.
+func (q *QQ) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	if lower, upper := q.Band(); lower != nil && q.BandColor != nil {
+		band := make([]vg.Point, 0, 2*len(lower))
+		for k, y := range lower {
+			band = append(band, vg.Point{X: trX(q.XYs[k].X), Y: trY(y)})
+		}
+		for k := len(upper) - 1; k >= 0; k-- {
+			band = append(band, vg.Point{X: trX(q.XYs[k].X), Y: trY(upper[k])})
+		}
+		c.FillPolygon(q.BandColor, c.ClipPolygonXY(band))
+	}
+	if q.LineStyle.Width != 0 && isFinite(q.Intercept) && isFinite(q.Slope) {
+		xmin, xmax := plt.X.Min, plt.X.Max
+		line := []vg.Point{
+			{X: trX(xmin), Y: trY(q.Intercept + q.Slope*xmin)},
+			{X: trX(xmax), Y: trY(q.Intercept + q.Slope*xmax)},
+		}
+		c.StrokeLines(q.LineStyle, c.ClipLinesXY(line)...)
+	}
+	q.Scatter.Plot(c, plt)
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The range includes the confidence band if it is drawn.
+func (q *QQ) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax, ymin, ymax = q.Scatter.DataRange()
+	if lower, upper := q.Band(); lower != nil && q.BandColor != nil {
+		lmin, _ := Range(Values(lower))
+		_, umax := Range(Values(upper))
+		ymin = math.Min(ymin, lmin)
+		ymax = math.Max(ymax, umax)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// NormalProbabilityTicks is suitable for an axis of the
+// quantiles of the standard normal distribution, such as the
+// X axis of a plot from NewNormalQQ, marking the quantiles of
+// a set of cumulative probabilities with the probabilities as
+// percentages.
+type NormalProbabilityTicks struct{}
+
+var _ plot.Ticker = NormalProbabilityTicks{}
+
+// normalProbabilities are the probabilities
+// marked by NormalProbabilityTicks.
+var normalProbabilities = []float64{
+	0.0001, 0.001, 0.01, 0.05, 0.1, 0.25, 0.5,
+	0.75, 0.9, 0.95, 0.99, 0.999, 0.9999,
+}
+
+// Ticks returns Ticks in the specified range.
+func (NormalProbabilityTicks) Ticks(min, max float64) []plot.Tick {
+	var ticks []plot.Tick
+	for _, p := range normalProbabilities {
+		v := stdNormal{}.Quantile(p)
+		if v < min || v > max {
+			continue
+		}
+		ticks = append(ticks, plot.Tick{Value: v, Label: strconv.FormatFloat(100*p, 'g', 6, 64)})
+	}
+	return ticks
+}
@@ -0,0 +1,202 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/stat/distuv"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleNewNormalQQ draws a normal probability plot of
+// a skewed sample, with a 95% confidence band.
+func ExampleNewNormalQQ() {
+	rnd := rand.New(rand.NewSource(1))
+	sample := make(Values, 100)
+	for i := range sample {
+		sample[i] = rnd.ExpFloat64()
+	}
+
+	qq, err := NewNormalQQ(sample)
+	if err != nil {
+		log.Panic(err)
+	}
+	qq.Confidence = 0.95
+	qq.LineStyle.Dashes = []vg.Length{vg.Points(4), vg.Points(2)}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Normal probability plot"
+	p.X.Label.Text = "Cumulative probability (%)"
+	p.X.Tick.Marker = NormalProbabilityTicks{}
+	p.Y.Label.Text = "Sample"
+	p.Add(qq)
+
+	err = p.Save(300, 300, "testdata/qq.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestNewNormalQQ(t *testing.T) {
+	cmpimg.CheckPlot(ExampleNewNormalQQ, t, "qq.png")
+}
+
+func TestQuantileMethod(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+	for _, test := range []struct {
+		m    QuantileMethod
+		p    float64
+		want float64
+	}{
+		{m: QuantileR7, p: 0, want: 1},
+		{m: QuantileR7, p: 0.5, want: 2.5},
+		{m: QuantileR7, p: 0.25, want: 1.75},
+		{m: QuantileR7, p: 1, want: 4},
+		{m: QuantileR4, p: 0.25, want: 1},
+		{m: QuantileR4, p: 0.6, want: 2.4},
+		{m: QuantileR5, p: 0.25, want: 1.5},
+		{m: QuantileR6, p: 0.25, want: 1.25},
+		{m: QuantileR6, p: 0.9, want: 4},
+		{m: QuantileR8, p: 0.5, want: 2.5},
+		{m: QuantileR9, p: 0.5, want: 2.5},
+	} {
+		got := test.m.Quantile(x, test.p)
+		if math.Abs(got-test.want) > 1e-12 {
+			t.Errorf("unexpected %v quantile %v: got:%v want:%v", test.m, test.p, got, test.want)
+		}
+	}
+
+	// The kth value is the quantile at its plotting position.
+	for _, m := range []QuantileMethod{QuantileR4, QuantileR5, QuantileR6, QuantileR7, QuantileR8, QuantileR9} {
+		for k := 1; k <= len(x); k++ {
+			got := m.Quantile(x, m.position(k, len(x)))
+			if math.Abs(got-x[k-1]) > 1e-12 {
+				t.Errorf("unexpected %v quantile at position of value %d: got:%v want:%v", m, k, got, x[k-1])
+			}
+		}
+	}
+}
+
+func TestQQ(t *testing.T) {
+	sample := Values{3, 1, 4, 1, 5, 9, 2, 6}
+	qq, err := NewNormalQQ(sample)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	n := len(sample)
+	for k, xy := range qq.XYs {
+		p := (float64(k+1) - 3.0/8) / (float64(n) + 1.0/4)
+		want := distuv.UnitNormal.Quantile(p)
+		if math.Abs(xy.X-want) > 1e-12 {
+			t.Errorf("unexpected theoretical quantile %d: got:%v want:%v", k, xy.X, want)
+		}
+		if k > 0 && xy.Y < qq.XYs[k-1].Y {
+			t.Errorf("sample quantiles not sorted at %d", k)
+		}
+	}
+
+	// The reference line passes through the quartiles.
+	sorted := []float64{1, 1, 2, 3, 4, 5, 6, 9}
+	for _, p := range []float64{0.25, 0.75} {
+		x := distuv.UnitNormal.Quantile(p)
+		want := QuantileR9.Quantile(sorted, p)
+		if got := qq.Intercept + qq.Slope*x; math.Abs(got-want) > 1e-12 {
+			t.Errorf("unexpected reference line at quantile %v: got:%v want:%v", p, got, want)
+		}
+	}
+
+	if lower, _ := qq.Band(); lower != nil {
+		t.Errorf("unexpected band without confidence level")
+	}
+	qq.Confidence = 0.95
+	lower, upper := qq.Band()
+	if len(lower) != n || len(upper) != n {
+		t.Fatalf("unexpected band length: got:%d,%d want:%d", len(lower), len(upper), n)
+	}
+	for k, xy := range qq.XYs {
+		line := qq.Intercept + qq.Slope*xy.X
+		if !(lower[k] < line && line < upper[k]) {
+			t.Errorf("reference line outside band at %d: %v not in [%v, %v]", k, line, lower[k], upper[k])
+		}
+	}
+	_, _, ymin, ymax := qq.DataRange()
+	if ymin > lower[0] || ymax < upper[n-1] {
+		t.Errorf("data range [%v, %v] does not include band", ymin, ymax)
+	}
+
+	_, err = NewQQ(sample, distuv.UnitNormal, QuantileR7)
+	if err == nil {
+		t.Errorf("expected error for infinite theoretical quantiles")
+	}
+}
+
+func TestStdNormalQuantile(t *testing.T) {
+	for _, p := range []float64{
+		1e-300, 1e-20, 1e-10, 1e-5, 0.001, 0.02425, 0.1, 0.3, 0.5,
+		0.7, 0.9, 0.97575, 0.999, 1 - 1e-5, 1 - 1e-10, 1 - 1e-15,
+	} {
+		got := stdNormal{}.Quantile(p)
+		want := distuv.UnitNormal.Quantile(p)
+		if math.Abs(got-want) > 1e-13*math.Max(1, math.Abs(want)) {
+			t.Errorf("unexpected quantile at %v: got:%v want:%v", p, got, want)
+		}
+	}
+	if q := (stdNormal{}).Quantile(0); !math.IsInf(q, -1) {
+		t.Errorf("unexpected quantile at 0: got:%v want:-Inf", q)
+	}
+	if q := (stdNormal{}).Quantile(1); !math.IsInf(q, 1) {
+		t.Errorf("unexpected quantile at 1: got:%v want:+Inf", q)
+	}
+}
+
+func TestQQSamples(t *testing.T) {
+	x := Values{0, 1, 2, 3, 4, 5, 6, 7, 8}
+	y := Values{10, 0, 20}
+	qq, err := NewQQSamples(x, y, QuantileR7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := XYs{{X: 0, Y: 0}, {X: 4, Y: 10}, {X: 8, Y: 20}}
+	if len(qq.XYs) != len(want) {
+		t.Fatalf("unexpected number of points: got:%d want:%d", len(qq.XYs), len(want))
+	}
+	for i, xy := range qq.XYs {
+		if xy != want[i] {
+			t.Errorf("unexpected point %d: got:%v want:%v", i, xy, want[i])
+		}
+	}
+	if qq.Slope != 2.5 || qq.Intercept != 0 {
+		t.Errorf("unexpected reference line: got:%v+%vx want:0+2.5x", qq.Intercept, qq.Slope)
+	}
+	if lower, _ := qq.Band(); lower != nil {
+		t.Errorf("unexpected band for two samples")
+	}
+}
+
+func TestNormalProbabilityTicks(t *testing.T) {
+	ticks := NormalProbabilityTicks{}.Ticks(-2, 2)
+	var labels []string
+	for _, tk := range ticks {
+		labels = append(labels, tk.Label)
+	}
+	want := []string{"5", "10", "25", "50", "75", "90", "95"}
+	if len(labels) != len(want) {
+		t.Fatalf("unexpected tick labels: got:%q want:%q", labels, want)
+	}
+	for i := range want {
+		if labels[i] != want[i] {
+			t.Errorf("unexpected tick label %d: got:%q want:%q", i, labels[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// XYUVer wraps the Len, XY and UV methods, describing
+// a vector field sampled at a set of points.
+type XYUVer interface {
+	// Len returns the number of vectors.
+	Len() int
+
+	// XY returns the position of a vector.
+	XY(int) (x, y float64)
+
+	// UV returns the u and v components of a vector.
+	UV(int) (u, v float64)
+}
+
+// XYUVs implements the XYUVer interface using a slice.
+type XYUVs []struct{ X, Y, U, V float64 }
+
+// Len implements the Len method of the XYUVer interface.
+func (xyuv XYUVs) Len() int {
+	return len(xyuv)
+}
+
+// XY implements the XY method of the XYUVer interface.
+func (xyuv XYUVs) XY(i int) (float64, float64) {
+	return xyuv[i].X, xyuv[i].Y
+}
+
+// UV implements the UV method of the XYUVer interface.
+func (xyuv XYUVs) UV(i int) (float64, float64) {
+	return xyuv[i].U, xyuv[i].V
+}
+
+// CopyXYUVs returns an XYUVs that is a copy of the positions
+// and vector components from an XYUVer, or an error if one of
+// the values is a NaN or Infinity.
+func CopyXYUVs(data XYUVer) (XYUVs, error) {
+	cpy := make(XYUVs, data.Len())
+	for i := range cpy {
+		cpy[i].X, cpy[i].Y = data.XY(i)
+		cpy[i].U, cpy[i].V = data.UV(i)
+		if err := CheckFloats(cpy[i].X, cpy[i].Y, cpy[i].U, cpy[i].V); err != nil {
+			return nil, err
+		}
+	}
+	return cpy, nil
+}
+
+// GridXYUV describes a two dimensional vector field where
+// the X and Y coordinates are arranged on a rectangular grid.
+// The grid conventions follow those of GridXYZ.
+type GridXYUV interface {
+	// Dims returns the dimensions of the grid.
+	Dims() (c, r int)
+
+	// UV returns the u and v components of the vector
+	// at (c, r).
+	// It will panic if c or r are out of bounds for the grid.
+	UV(c, r int) (u, v float64)
+
+	// X returns the coordinate for the column at the index x.
+	// It will panic if c is out of bounds for the grid.
+	X(c int) float64
+
+	// Y returns the coordinate for the row at the index r.
+	// It will panic if r is out of bounds for the grid.
+	Y(r int) float64
+}
+
+// GridVectors implements the XYUVer interface, returning
+// the vectors of a GridXYUV in column-major order.
+type GridVectors struct{ GridXYUV }
+
+// Len implements the Len method of the XYUVer interface.
+func (g GridVectors) Len() int {
+	c, r := g.Dims()
+	return c * r
+}
+
+// XY implements the XY method of the XYUVer interface.
+func (g GridVectors) XY(i int) (float64, float64) {
+	_, r := g.Dims()
+	return g.X(i / r), g.Y(i % r)
+}
+
+// UV implements the UV method of the XYUVer interface.
+func (g GridVectors) UV(i int) (float64, float64) {
+	_, r := g.Dims()
+	return g.GridXYUV.UV(i/r, i%r)
+}
+
+// QuiverScaling specifies how the components of the
+// vectors drawn by a Quiver are converted to arrows.
+type QuiverScaling int
+
+const (
+	// QuiverAuto scales the vectors in data units so that
+	// the longest arrow is as long as the typical distance
+	// between the arrow positions. Scale is a multiplier
+	// applied to this automatic scaling.
+	QuiverAuto QuiverScaling = iota
+
+	// QuiverData draws each vector from (x, y) to
+	// (x + Scale*u, y + Scale*v) in data coordinates.
+	QuiverData
+
+	// QuiverScreen draws each vector in the direction of
+	// (u, v) on the canvas, with a length of Scale points
+	// per unit of vector magnitude.
+	QuiverScreen
+)
+
+// DefaultArrowHead is the default length of
+// arrow heads.
+var DefaultArrowHead = vg.Points(4)
+
+// Quiver implements the Plotter interface, drawing an
+// arrow for each vector of a vector field.
+type Quiver struct {
+	// XYUVs is a copy of the vectors for this quiver.
+	XYUVs
+
+	// Scaling specifies how vector components are
+	// converted to arrows.
+	Scaling QuiverScaling
+
+	// Scale is the scale factor used by Scaling.
+	Scale float64
+
+	// LineStyle is the style of the arrow shafts. If
+	// Palette is nil, its color is also used for the
+	// arrow heads.
+	draw.LineStyle
+
+	// HeadLength is the length of the arrow heads. Arrow
+	// heads are shortened to half the length of arrows
+	// shorter than twice HeadLength.
+	HeadLength vg.Length
+
+	// Palette, if not nil, is used to color the arrows
+	// according to the vector magnitudes, scaled uniformly
+	// between Min and Max. Magnitudes outside the range
+	// are given the color of the nearest bound.
+	Palette palette.Palette
+
+	// Min and Max define the range of magnitudes mapped
+	// to the colors of Palette.
+	Min, Max float64
+}
+
+// NewQuiver returns a Quiver for the given vectors using
+// automatic scaling and the default line style. Min and
+// Max are set to the range of the vector magnitudes.
+func NewQuiver(vecs XYUVer) (*Quiver, error) {
+	data, err := CopyXYUVs(vecs)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, d := range data {
+		m := math.Hypot(d.U, d.V)
+		min = math.Min(min, m)
+		max = math.Max(max, m)
+	}
+	return &Quiver{
+		XYUVs:      data,
+		Scale:      1,
+		LineStyle:  DefaultLineStyle,
+		HeadLength: DefaultArrowHead,
+		Min:        min,
+		Max:        max,
+	}, nil
+}
+
+// dataScale returns the factor converting vector components
+// to data units for the QuiverAuto and QuiverData scalings.
+func (q *Quiver) dataScale() float64 {
+	if q.Scaling != QuiverAuto {
+		return q.Scale
+	}
+	var maxMag float64
+	for _, d := range q.XYUVs {
+		maxMag = math.Max(maxMag, math.Hypot(d.U, d.V))
+	}
+	if maxMag == 0 {
+		return 0
+	}
+	xmin, xmax, ymin, ymax := XYRange(q)
+	dx, dy := xmax-xmin, ymax-ymin
+	var spacing float64
+	n := float64(len(q.XYUVs))
+	switch {
+	case dx > 0 && dy > 0:
+		spacing = math.Sqrt(dx * dy / n)
+	case dx > 0 || dy > 0:
+		spacing = (dx + dy) / n
+	default:
+		spacing = 1
+	}
+	return q.Scale * spacing / maxMag
+}
+
+// color returns the color of the ith arrow.
+func (q *Quiver) color(i int) color.Color {
+	if q.Palette == nil {
+		return q.LineStyle.Color
+	}
+	return paletteColor(q.Palette, q.Min, q.Max, math.Hypot(q.XYUVs[i].U, q.XYUVs[i].V))
+}
+
+// paletteColor returns the color of p for the value v, with
+// the palette scaled uniformly between min and max. Values
+// outside the range are given the color of the nearest bound.
+func paletteColor(p palette.Palette, min, max, v float64) color.Color {
+	pal := p.Colors()
+	if len(pal) == 0 {
+		panic("plotter: empty palette")
+	}
+	if max <= min || math.IsNaN(v) {
+		return pal[0]
+	}
+	idx := int((v-min)/(max-min)*float64(len(pal)-1) + 0.5)
+	switch {
+	case idx < 0:
+		idx = 0
+	case idx >= len(pal):
+		idx = len(pal) - 1
+	}
+	return pal[idx]
+}
+
+// arrow returns the tail and tip of the ith arrow
+// on the canvas.
+func (q *Quiver) arrow(i int, trX, trY func(float64) vg.Length, scale float64) (tail, tip vg.Point) {
+	d := q.XYUVs[i]
+	tail = vg.Point{X: trX(d.X), Y: trY(d.Y)}
+	if q.Scaling == QuiverScreen {
+		tip = tail.Add(vg.Point{X: vg.Length(q.Scale * d.U), Y: vg.Length(q.Scale * d.V)})
+		return tail, tip
+	}
+	return tail, vg.Point{X: trX(d.X + scale*d.U), Y: trY(d.Y + scale*d.V)}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (q *Quiver) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	scale := q.dataScale()
+	for i := range q.XYUVs {
+		tail, tip := q.arrow(i, trX, trY, scale)
+		if !c.Contains(tail) {
+			continue
+		}
+		sty := q.LineStyle
+		sty.Color = q.color(i)
+		drawArrow(&c, sty, tail, tip, q.HeadLength)
+	}
+}
+
+// drawArrow draws an arrow from tail to tip with a filled
+// head of the given length, clipped to the canvas. The head
+// is shortened to half the length of the arrow if the arrow
+// is shorter than twice the head length.
+func drawArrow(c *draw.Canvas, sty draw.LineStyle, tail, tip vg.Point, head vg.Length) {
+	d := tip.Sub(tail)
+	l := vg.Length(math.Hypot(float64(d.X), float64(d.Y)))
+	if l == 0 {
+		return
+	}
+	if head > l/2 {
+		head = l / 2
+	}
+	u := d.Scale(1 / l)
+	c.StrokeLines(sty, c.ClipLinesXY([]vg.Point{tail, tip.Sub(u.Scale(head))})...)
+	drawArrowHead(c, sty.Color, tip, u, head)
+}
+
+// drawArrowHead draws a filled arrow head of the given
+// length with its point at tip, pointing in the direction
+// of the unit vector u, clipped to the canvas.
+func drawArrowHead(c *draw.Canvas, clr color.Color, tip, u vg.Point, head vg.Length) {
+	n := vg.Point{X: -u.Y, Y: u.X}
+	base := tip.Sub(u.Scale(head))
+	half := head * vg.Length(math.Tan(arrowHeadAngle))
+	pts := []vg.Point{tip, base.Add(n.Scale(half)), base.Sub(n.Scale(half))}
+	c.FillPolygon(clr, c.ClipPolygonXY(pts))
+}
+
+// arrowHeadAngle is the half angle of arrow heads.
+const arrowHeadAngle = math.Pi / 8
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface. For the QuiverAuto
+// and QuiverData scalings the range includes the arrow
+// tips.
+func (q *Quiver) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax, ymin, ymax = XYRange(q)
+	if q.Scaling == QuiverScreen {
+		return xmin, xmax, ymin, ymax
+	}
+	scale := q.dataScale()
+	for _, d := range q.XYUVs {
+		x, y := d.X+scale*d.U, d.Y+scale*d.V
+		xmin, xmax = math.Min(xmin, x), math.Max(xmax, x)
+		ymin, ymax = math.Min(ymin, y), math.Max(ymax, y)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// GlyphBoxes implements the GlyphBoxes method
+// of the plot.GlyphBoxer interface. Glyph boxes
+// are only returned for the QuiverScreen scaling,
+// where arrows extend by a fixed length on the
+// canvas.
+func (q *Quiver) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	if q.Scaling != QuiverScreen {
+		return nil
+	}
+	bs := make([]plot.GlyphBox, len(q.XYUVs))
+	for i, d := range q.XYUVs {
+		bs[i].X = plt.X.Norm(d.X)
+		bs[i].Y = plt.Y.Norm(d.Y)
+		dx, dy := vg.Length(q.Scale*d.U), vg.Length(q.Scale*d.V)
+		bs[i].Rectangle = vg.Rectangle{
+			Min: vg.Point{X: vg.Length(math.Min(0, float64(dx))), Y: vg.Length(math.Min(0, float64(dy)))},
+			Max: vg.Point{X: vg.Length(math.Max(0, float64(dx))), Y: vg.Length(math.Max(0, float64(dy)))},
+		}
+	}
+	return bs
+}
+
+// Thumbnail draws a horizontal arrow across the thumbnail,
+// implementing the plot.Thumbnailer interface.
+func (q *Quiver) Thumbnail(c *draw.Canvas) {
+	sty := q.LineStyle
+	if q.Palette != nil {
+		sty.Color = paletteColor(q.Palette, q.Min, q.Max, (q.Min+q.Max)/2)
+	}
+	y := c.Center().Y
+	drawArrow(c, sty, vg.Point{X: c.Min.X, Y: y}, vg.Point{X: c.Max.X, Y: y}, q.HeadLength)
+}
+
+// QuiverKey implements the Plotter interface, drawing a
+// reference arrow for a Quiver with a label giving its
+// magnitude.
+type QuiverKey struct {
+	// Quiver is the quiver whose scaling and
+	// style are used to draw the reference arrow.
+	Quiver *Quiver
+
+	// X and Y are the data coordinates of the
+	// tail of the reference arrow.
+	X, Y float64
+
+	// Magnitude is the magnitude of the
+	// horizontal reference vector.
+	Magnitude float64
+
+	// Label is the text drawn after the arrow.
+	Label string
+
+	// TextStyle is the style of the label text.
+	draw.TextStyle
+}
+
+// NewQuiverKey returns a QuiverKey for q with the arrow tail
+// at (x, y), using the DefaultFont and DefaultFontSize.
+func NewQuiverKey(q *Quiver, x, y, mag float64, label string) (*QuiverKey, error) {
+	if q == nil {
+		return nil, errors.New("plotter: nil Quiver for QuiverKey")
+	}
+	if err := CheckFloats(x, y, mag); err != nil {
+		return nil, err
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	return &QuiverKey{
+		Quiver:    q,
+		X:         x,
+		Y:         y,
+		Magnitude: mag,
+		Label:     label,
+		TextStyle: draw.TextStyle{Font: fnt, YAlign: draw.YCenter},
+	}, nil
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (k *QuiverKey) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	q := k.Quiver
+	tail := vg.Point{X: trX(k.X), Y: trY(k.Y)}
+	if !c.Contains(tail) {
+		return
+	}
+	tip := tail.Add(vg.Point{X: vg.Length(q.Scale * k.Magnitude)})
+	if q.Scaling != QuiverScreen {
+		tip.X = trX(k.X + q.dataScale()*k.Magnitude)
+	}
+	sty := q.LineStyle
+	if q.Palette != nil {
+		sty.Color = paletteColor(q.Palette, q.Min, q.Max, k.Magnitude)
+	}
+	drawArrow(&c, sty, tail, tip, q.HeadLength)
+	pad := k.TextStyle.Rectangle(" ").Max.X
+	c.FillText(k.TextStyle, vg.Point{X: tip.X + pad, Y: tip.Y}, k.Label)
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (k *QuiverKey) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return k.X, k.X, k.Y, k.Y
+}
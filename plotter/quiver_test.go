@@ -0,0 +1,135 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette"
+)
+
+// vortexField is a GridXYUV holding a vortex
+// sampled on a regular grid.
+type vortexField struct {
+	n          int
+	min, delta float64
+}
+
+func (f vortexField) Dims() (c, r int) { return f.n, f.n }
+func (f vortexField) X(c int) float64  { return f.min + float64(c)*f.delta }
+func (f vortexField) Y(r int) float64  { return f.min + float64(r)*f.delta }
+func (f vortexField) UV(c, r int) (u, v float64) {
+	x, y := f.X(c), f.Y(r)
+	return -y, x
+}
+
+func ExampleQuiver() {
+	field := vortexField{n: 11, min: -1, delta: 0.2}
+
+	q, err := NewQuiver(GridVectors{field})
+	if err != nil {
+		log.Panic(err)
+	}
+	q.Palette = palette.Heat(16, 1)
+	q.Scale = 0.9
+
+	key, err := NewQuiverKey(q, 0.6, 1.3, 1, "1 m/s")
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Vortex"
+	p.Add(q, key)
+	p.Legend.Add("velocity", q)
+	p.Legend.Left = true
+	p.Legend.Top = true
+	err = p.Save(250, 250, "testdata/quiver.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestQuiver(t *testing.T) {
+	cmpimg.CheckPlot(ExampleQuiver, t, "quiver.png")
+}
+
+func TestGridVectors(t *testing.T) {
+	g := GridVectors{vortexField{n: 3, min: 0, delta: 1}}
+	if g.Len() != 9 {
+		t.Fatalf("unexpected length: got:%d want:9", g.Len())
+	}
+	// Vectors are returned in column-major order.
+	x, y := g.XY(5)
+	u, v := g.UV(5)
+	if x != 1 || y != 2 || u != -2 || v != 1 {
+		t.Errorf("unexpected vector 5: got:(%v, %v, %v, %v) want:(1, 2, -2, 1)", x, y, u, v)
+	}
+}
+
+func TestQuiverScaling(t *testing.T) {
+	q, err := NewQuiver(XYUVs{
+		{X: 0, Y: 0, U: 1, V: 0},
+		{X: 2, Y: 0, U: 0, V: -2},
+		{X: 0, Y: 2, U: 0, V: 0},
+		{X: 2, Y: 2, U: 0, V: 0},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Min != 0 || q.Max != 2 {
+		t.Errorf("unexpected magnitude range: got:[%v, %v] want:[0, 2]", q.Min, q.Max)
+	}
+
+	// The typical spacing is √(2*2/4) = 1, and the longest
+	// vector has a magnitude of 2.
+	if got := q.dataScale(); got != 0.5 {
+		t.Errorf("unexpected automatic scale: got:%v want:0.5", got)
+	}
+	xmin, xmax, ymin, ymax := q.DataRange()
+	if xmin != 0 || xmax != 2 || ymin != -1 || ymax != 2 {
+		t.Errorf("unexpected auto data range: got:[%v %v %v %v] want:[0 2 -1 2]", xmin, xmax, ymin, ymax)
+	}
+
+	q.Scaling = QuiverData
+	q.Scale = 2
+	_, _, ymin, _ = q.DataRange()
+	if ymin != -4 {
+		t.Errorf("unexpected data scaling minimum: got:%v want:-4", ymin)
+	}
+
+	q.Scaling = QuiverScreen
+	_, _, ymin, _ = q.DataRange()
+	if ymin != 0 {
+		t.Errorf("unexpected screen scaling minimum: got:%v want:0", ymin)
+	}
+}
+
+func TestPaletteColor(t *testing.T) {
+	p := palette.Heat(5, 1)
+	pal := p.Colors()
+	for _, test := range []struct {
+		v    float64
+		want int
+	}{
+		{v: -1, want: 0},
+		{v: 0, want: 0},
+		{v: 0.5, want: 2},
+		{v: 1, want: 4},
+		{v: 2, want: 4},
+		{v: math.NaN(), want: 0},
+	} {
+		if got := paletteColor(p, 0, 1, test.v); got != pal[test.want] {
+			t.Errorf("unexpected color for %v: got:%v want:%v", test.v, got, pal[test.want])
+		}
+	}
+}
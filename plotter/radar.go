@@ -0,0 +1,278 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// RadarSpoke is a spoke of a Radar chart.
+type RadarSpoke struct {
+	// Label is the text drawn beyond
+	// the tip of the spoke.
+	Label string
+
+	// Min and Max are the values at the center
+	// and the tip of the spoke. If Min is greater
+	// than Max, the spoke is inverted.
+	Min, Max float64
+}
+
+// norm returns the value v normalized to
+// its distance along the spoke.
+func (s RadarSpoke) norm(v float64) float64 {
+	return (v - s.Min) / (s.Max - s.Min)
+}
+
+// RadarSeries is a series of values of a Radar
+// chart, one for each spoke, drawn as a polygon.
+type RadarSeries struct {
+	// Values holds the value of each spoke.
+	Values Values
+
+	// LineStyle is the style of the outline of the
+	// series. If the width is zero, no outline is
+	// drawn.
+	draw.LineStyle
+
+	// Color is the fill color of the series.
+	// If Color is nil, the series is not filled.
+	Color color.Color
+}
+
+// Thumbnail implements the plot.Thumbnailer interface,
+// drawing the series as a Polygon thumbnail.
+func (s *RadarSeries) Thumbnail(c *draw.Canvas) {
+	(&Polygon{LineStyle: s.LineStyle, Color: s.Color}).Thumbnail(c)
+}
+
+// Radar implements the Plotter interface, drawing a radar
+// chart: a series of values for a set of variables is drawn
+// as a polygon with its vertices on spokes radiating from a
+// center, one spoke for each variable.
+//
+// The chart is drawn as a circle around the origin with
+// a data radius of one, and with the first spoke pointing
+// up and the following ones placed clockwise. The chart is
+// kept circular when the X and Y axes have different
+// scales, so the axes of the plot are usually hidden.
+type Radar struct {
+	// Spokes holds the spokes of the chart.
+	Spokes []RadarSpoke
+
+	// Series holds the series drawn on the chart,
+	// in order.
+	Series []*RadarSeries
+
+	// GridLevels is the number of concentric grid
+	// polygons, evenly spaced from the center to
+	// the tips of the spokes.
+	GridLevels int
+
+	// GridStyle is the style of the grid polygons
+	// and SpokeStyle is the style of the spokes.
+	// If a width is zero, those lines are not drawn.
+	GridStyle  draw.LineStyle
+	SpokeStyle draw.LineStyle
+
+	// LabelStyle is the style of the spoke labels.
+	LabelStyle draw.TextStyle
+}
+
+// NewRadar returns a Radar chart with a spoke for each label,
+// and a series for each of the given series of values. Each
+// spoke ranges from zero at the center to the largest value of
+// the spoke. The series are drawn with the default line style
+// and no fill. An error is returned if there are fewer than
+// three spokes, if a series does not have a value for each
+// spoke, or if a value is NaN or infinite.
+func NewRadar(labels []string, series ...Valuer) (*Radar, error) {
+	if len(labels) < 3 {
+		return nil, errors.New("plotter: radar chart needs at least three spokes")
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Radar{
+		Spokes:     make([]RadarSpoke, len(labels)),
+		GridLevels: 4,
+		GridStyle: draw.LineStyle{
+			Color: color.Gray{Y: 192},
+			Width: vg.Points(0.5),
+		},
+		SpokeStyle: draw.LineStyle{
+			Color: color.Gray{Y: 192},
+			Width: vg.Points(0.5),
+		},
+		LabelStyle: draw.TextStyle{
+			Color: color.Black,
+			Font:  fnt,
+		},
+	}
+	for i, l := range labels {
+		r.Spokes[i].Label = l
+	}
+	for i, v := range series {
+		vals, err := CopyValues(v)
+		if err != nil {
+			return nil, err
+		}
+		if len(vals) != len(labels) {
+			return nil, fmt.Errorf("plotter: radar series %d has %d values for %d spokes", i, len(vals), len(labels))
+		}
+		for j, v := range vals {
+			r.Spokes[j].Max = math.Max(r.Spokes[j].Max, v)
+		}
+		r.Series = append(r.Series, &RadarSeries{Values: vals, LineStyle: DefaultLineStyle})
+	}
+	for i := range r.Spokes {
+		if r.Spokes[i].Max == r.Spokes[i].Min {
+			r.Spokes[i].Max++
+		}
+	}
+	return r, nil
+}
+
+// radarGap is the space between the tips
+// of the spokes of a Radar and their labels.
+const radarGap = vg.Length(4)
+
+// Plot implements the Plotter interface.
+func (r *Radar) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	center := vg.Point{X: trX(0), Y: trY(0)}
+	radius := minLength(trX(1)-center.X, trY(1)-center.Y)
+	n := len(r.Spokes)
+
+	// at returns the point at the normalized
+	// distance d along spoke i.
+	at := func(i int, d float64) vg.Point {
+		sin, cos := math.Sincos(r.angle(i))
+		return vg.Point{
+			X: center.X + radius*vg.Length(d*cos),
+			Y: center.Y + radius*vg.Length(d*sin),
+		}
+	}
+
+	if r.GridStyle.Width != 0 {
+		for k := 1; k <= r.GridLevels; k++ {
+			ring := make([]vg.Point, n+1)
+			for i := range ring {
+				ring[i] = at(i%n, float64(k)/float64(r.GridLevels))
+			}
+			c.StrokeLines(r.GridStyle, c.ClipLinesXY(ring)...)
+		}
+	}
+	if r.SpokeStyle.Width != 0 {
+		for i := range r.Spokes {
+			c.StrokeLines(r.SpokeStyle, c.ClipLinesXY([]vg.Point{center, at(i, 1)})...)
+		}
+	}
+
+	for _, s := range r.Series {
+		ring := make([]vg.Point, n)
+		for i, v := range s.Values {
+			ring[i] = at(i, r.Spokes[i].norm(v))
+		}
+		if s.Color != nil {
+			c.FillPolygon(s.Color, c.ClipPolygonXY(ring))
+		}
+		if s.LineStyle.Width != 0 {
+			c.StrokeLines(s.LineStyle, c.ClipLinesXY(append(ring, ring[0]))...)
+		}
+	}
+
+	for i, s := range r.Spokes {
+		if s.Label == "" {
+			continue
+		}
+		sty, off := r.label(i)
+		c.FillText(sty, at(i, 1).Add(off), s.Label)
+	}
+}
+
+// label returns the style of the label of spoke i
+// and the offset from the tip of the spoke at which
+// the label is drawn.
+func (r *Radar) label(i int) (draw.TextStyle, vg.Point) {
+	sty := r.LabelStyle
+	sty.XAlign, sty.YAlign = r.labelAlign(i)
+	sin, cos := math.Sincos(r.angle(i))
+	return sty, vg.Point{X: radarGap * vg.Length(cos), Y: radarGap * vg.Length(sin)}
+}
+
+// angle returns the angle of spoke i, counter-clockwise from
+// the positive X direction. The first spoke points up, and
+// the following ones are placed clockwise.
+func (r *Radar) angle(i int) float64 {
+	return math.Pi/2 - 2*math.Pi*float64(i)/float64(len(r.Spokes))
+}
+
+// labelAlign returns the alignment of the label of spoke i,
+// placing the label beyond the tip of the spoke.
+func (r *Radar) labelAlign(i int) (draw.XAlignment, draw.YAlignment) {
+	const eps = 1e-6
+	sin, cos := math.Sincos(r.angle(i))
+	xalign := draw.XCenter
+	switch {
+	case cos > eps:
+		xalign = draw.XLeft
+	case cos < -eps:
+		xalign = draw.XRight
+	}
+	yalign := draw.YCenter
+	switch {
+	case sin > eps:
+		yalign = draw.YBottom
+	case sin < -eps:
+		yalign = draw.YTop
+	}
+	return xalign, yalign
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (r *Radar) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return -1, 1, -1, 1
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface,
+// making room for the spoke labels.
+//
+// Plot draws the spokes with the length of the smaller of
+// the half width and half height of the data area, so the
+// tips of the spokes lie on the unit circle of the data
+// coordinates along the smaller dimension and within it
+// along the larger. Since the size of the data area is not
+// known here, each label box is placed beyond the tip of
+// its spoke on the unit circle, as Plot places the labels,
+// which places the boxes exactly along the dimension that
+// limits the spokes and keeps the labels within them along
+// the other.
+func (r *Radar) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	for i, s := range r.Spokes {
+		if s.Label == "" {
+			continue
+		}
+		sty, off := r.label(i)
+		sin, cos := math.Sincos(r.angle(i))
+		box := sty.Rectangle(s.Label)
+		boxes = append(boxes, plot.GlyphBox{
+			X:         plt.X.Norm(cos),
+			Y:         plt.Y.Norm(sin),
+			Rectangle: vg.Rectangle{Min: box.Min.Add(off), Max: box.Max.Add(off)},
+		})
+	}
+	return boxes
+}
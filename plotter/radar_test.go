@@ -0,0 +1,171 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// ExampleRadar compares the benchmark profiles of two systems.
+func ExampleRadar() {
+	metrics := []string{"throughput", "latency", "memory", "startup", "binary size", "accuracy"}
+	a := Values{820, 12, 256, 0.8, 14, 0.92}
+	b := Values{640, 7, 180, 1.6, 9, 0.97}
+
+	r, err := NewRadar(metrics, a, b)
+	if err != nil {
+		log.Panic(err)
+	}
+	// Lower latency is better, so
+	// invert the latency spoke.
+	r.Spokes[1].Min, r.Spokes[1].Max = 20, 0
+	r.Spokes[5].Min = 0.8
+
+	r.Series[0].Color = color.NRGBA{R: 255, A: 64}
+	r.Series[0].LineStyle.Color = color.NRGBA{R: 255, A: 255}
+	r.Series[1].Color = color.NRGBA{B: 255, A: 64}
+	r.Series[1].LineStyle.Color = color.NRGBA{B: 255, A: 255}
+	r.Series[1].Dashes = []vg.Length{vg.Points(4), vg.Points(2)}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Benchmark profiles"
+	p.Add(r)
+	p.Legend.Add("system A", r.Series[0])
+	p.Legend.Add("system B", r.Series[1])
+	p.Legend.Top = true
+	p.HideAxes()
+
+	err = p.Save(300, 250, "testdata/radar.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestRadar(t *testing.T) {
+	cmpimg.CheckPlot(ExampleRadar, t, "radar.png")
+}
+
+func TestNewRadar(t *testing.T) {
+	r, err := NewRadar([]string{"a", "b", "c", "d"}, Values{1, 2, 0, 4}, Values{3, 1, 0, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []float64{3, 2, 1, 4} {
+		if s := r.Spokes[i]; s.Min != 0 || s.Max != want {
+			t.Errorf("unexpected range of spoke %d: got:[%v, %v] want:[0, %v]", i, s.Min, s.Max, want)
+		}
+	}
+
+	for _, test := range []struct {
+		name   string
+		labels []string
+		series []Valuer
+	}{
+		{name: "too few spokes", labels: []string{"a", "b"}},
+		{name: "short series", labels: []string{"a", "b", "c"}, series: []Valuer{Values{1, 2}}},
+		{name: "NaN", labels: []string{"a", "b", "c"}, series: []Valuer{Values{1, 2, math.NaN()}}},
+	} {
+		if _, err := NewRadar(test.labels, test.series...); err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+}
+
+func TestRadarLabelAlign(t *testing.T) {
+	r, err := NewRadar([]string{"n", "e", "s", "w"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []struct {
+		x draw.XAlignment
+		y draw.YAlignment
+	}{
+		{x: draw.XCenter, y: draw.YBottom},
+		{x: draw.XLeft, y: draw.YCenter},
+		{x: draw.XCenter, y: draw.YTop},
+		{x: draw.XRight, y: draw.YCenter},
+	} {
+		x, y := r.labelAlign(i)
+		if x != want.x || y != want.y {
+			t.Errorf("unexpected alignment of spoke %d: got:(%v, %v) want:(%v, %v)", i, x, y, want.x, want.y)
+		}
+	}
+}
+
+func TestRadarLabels(t *testing.T) {
+	r, err := NewRadar([]string{"n", "e", "s", "w"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(r)
+
+	// On a wide canvas the spokes are limited by its
+	// height, and the labels are placed beyond their tips.
+	var rec recorder.Canvas
+	r.Plot(draw.NewCanvas(&rec, 200, 100), p)
+	var got []vg.Point
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			got = append(got, a.Point)
+		}
+	}
+	want := []vg.Point{
+		{X: 100, Y: 100 + radarGap},
+		{X: 150 + radarGap, Y: 50},
+		{X: 100, Y: -radarGap},
+		{X: 50 - radarGap, Y: 50},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of labels: got:%d want:%d", len(got), len(want))
+	}
+	for i := range want {
+		// Draw the label at the expected point to
+		// take account of its alignment.
+		var ref recorder.Canvas
+		sty, _ := r.label(i)
+		c := draw.NewCanvas(&ref, 200, 100)
+		c.FillText(sty, want[i], r.Spokes[i].Label)
+		w := ref.Actions[len(ref.Actions)-1].(*recorder.FillString).Point
+		if !samePoint(got[i], w) {
+			t.Errorf("unexpected position of label %d: got:%v want:%v", i, got[i], w)
+		}
+	}
+
+	// The glyph boxes are placed beyond the tips of the
+	// spokes on the unit circle, with the label offsets.
+	boxes := r.GlyphBoxes(p)
+	if len(boxes) != len(want) {
+		t.Fatalf("unexpected number of glyph boxes: got:%d want:%d", len(boxes), len(want))
+	}
+	for i, b := range boxes {
+		sty, off := r.label(i)
+		min := sty.Rectangle(r.Spokes[i].Label).Min.Add(off)
+		sin, cos := math.Sincos(r.angle(i))
+		x, y := (cos+1)/2, (sin+1)/2
+		if math.Abs(b.X-x) > 1e-12 || math.Abs(b.Y-y) > 1e-12 || !samePoint(b.Rectangle.Min, min) {
+			t.Errorf("unexpected glyph box %d: got:%+v want:(%v, %v) %v", i, b, x, y, min)
+		}
+	}
+}
+
+func samePoint(a, b vg.Point) bool {
+	return math.Abs(float64(a.X-b.X)) < 1e-9 && math.Abs(float64(a.Y-b.Y)) < 1e-9
+}
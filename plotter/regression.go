@@ -0,0 +1,701 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+	"strings"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Fit implements the Plotter interface, drawing a curve
+// fitted to data as a Line, with an optional confidence
+// band about the curve.
+type Fit struct {
+	// Line draws the fitted curve.
+	*Line
+
+	// RSquared is the coefficient of determination
+	// of the fit, the fraction of the variance of the
+	// Y values of the data explained by the fit. It
+	// is NaN if the Y values of the data are all equal.
+	RSquared float64
+
+	// StdErr is the residual standard error of the
+	// fit, estimating the standard deviation of the
+	// data about the fitted curve. It is NaN if the
+	// fit has no residual degrees of freedom, as for
+	// a polynomial through as many points as it has
+	// coefficients, and the band is then not drawn.
+	StdErr float64
+
+	// Confidence is the confidence level of the
+	// pointwise band about the fitted curve within
+	// which the true curve lies, assuming normally
+	// distributed errors. If Confidence is zero,
+	// the band is not drawn.
+	Confidence float64
+
+	// BandColor is the fill color of the confidence band.
+	BandColor color.Color
+
+	// stdErrs holds the standard errors of the fitted
+	// values at the points of the Line, and df is the
+	// residual degrees of freedom of the fit.
+	stdErrs []float64
+	df      float64
+}
+
+// newFit returns a Fit drawing the fitted curve through
+// the given points with the default styles.
+func newFit(xys XYs, stdErrs []float64) *Fit {
+	return &Fit{
+		Line:      &Line{XYs: xys, LineStyle: DefaultLineStyle},
+		BandColor: color.Gray{Y: 220},
+		stdErrs:   stdErrs,
+	}
+}
+
+// setResiduals sets the goodness of fit from the Y values of
+// the data and the fitted values at the data, for a fit using
+// the given effective number of parameters.
+func (f *Fit) setResiduals(ys, fitted []float64, params float64) {
+	var mean float64
+	for _, y := range ys {
+		mean += y
+	}
+	mean /= float64(len(ys))
+	var sse, sst float64
+	for i, y := range ys {
+		sse += (y - fitted[i]) * (y - fitted[i])
+		sst += (y - mean) * (y - mean)
+	}
+	f.df = float64(len(ys)) - params
+	f.RSquared = 1 - sse/sst
+	f.StdErr = math.NaN()
+	if f.df > 0 {
+		f.StdErr = math.Sqrt(sse / f.df)
+	}
+}
+
+// Band returns the lower and upper edges of the confidence
+// band at each point of the fitted curve, or nil if the band
+// is not drawn or there are too few points to estimate it.
+func (f *Fit) Band() (lower, upper []float64) {
+	if f.Confidence <= 0 || f.Confidence >= 1 || !(f.df > 0) {
+		return nil, nil
+	}
+	t := studentsTQuantile((1+f.Confidence)/2, f.df)
+	lower = make([]float64, len(f.XYs))
+	upper = make([]float64, len(f.XYs))
+	for i, xy := range f.XYs {
+		d := t * f.StdErr * f.stdErrs[i]
+		lower[i] = xy.Y - d
+		upper[i] = xy.Y + d
+	}
+	return lower, upper
+}
+
+// Plot implements the Plotter interface, drawing
+// the confidence band and the fitted curve.
+func (f *Fit) Plot(c draw.Canvas, plt *plot.Plot) {
+	if lower, upper := f.Band(); lower != nil && f.BandColor != nil {
+		trX, trY := plt.Transforms(&c)
+		band := make([]vg.Point, 0, 2*len(lower))
+		for i, y := range lower {
+			band = append(band, vg.Point{X: trX(f.XYs[i].X), Y: trY(y)})
+		}
+		for i := len(upper) - 1; i >= 0; i-- {
+			band = append(band, vg.Point{X: trX(f.XYs[i].X), Y: trY(upper[i])})
+		}
+		c.FillPolygon(f.BandColor, c.ClipPolygonXY(band))
+	}
+	f.Line.Plot(c, plt)
+}
+
+// DataRange implements the plot.DataRanger interface.
+// The range includes the confidence band if it is drawn.
+func (f *Fit) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax, ymin, ymax = f.Line.DataRange()
+	if lower, upper := f.Band(); lower != nil && f.BandColor != nil {
+		lmin, _ := Range(Values(lower))
+		_, umax := Range(Values(upper))
+		ymin = math.Min(ymin, lmin)
+		ymax = math.Max(ymax, umax)
+	}
+	return xmin, xmax, ymin, ymax
+}
+
+// fitSamples is the number of points at which
+// a fitted polynomial is drawn.
+const fitSamples = 100
+
+// PolynomialFit is a polynomial fitted to data by ordinary
+// least squares.
+type PolynomialFit struct {
+	// Fit draws the fitted polynomial.
+	*Fit
+
+	// Coeffs holds the coefficients of the fitted
+	// polynomial, starting from the constant term.
+	Coeffs []float64
+}
+
+// NewLinearFit returns the straight line fitted to the
+// data by ordinary least squares. An error is returned
+// if the data has fewer than two distinct X values.
+func NewLinearFit(xys XYer) (*PolynomialFit, error) {
+	return NewPolynomialFit(xys, 1)
+}
+
+// NewPolynomialFit returns the polynomial of the given degree
+// fitted to the data by ordinary least squares. The curve is
+// drawn over the range of the X values of the data. An error
+// is returned if the degree is negative or the data has too
+// few distinct X values to determine the polynomial.
+func NewPolynomialFit(xys XYer, degree int) (*PolynomialFit, error) {
+	if degree < 0 {
+		return nil, errors.New("plotter: negative polynomial degree")
+	}
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+
+	// The polynomial is fitted in t = (x-mid)/half,
+	// which lies in [-1, 1], to keep the normal
+	// equations well conditioned.
+	xmin, xmax := Range(XValues{data})
+	mid, half := (xmin+xmax)/2, (xmax-xmin)/2
+	if half == 0 {
+		half = 1
+	}
+	p := degree + 1
+	powers := func(x float64) []float64 {
+		v := make([]float64, p)
+		t := (x - mid) / half
+		pow := 1.0
+		for j := range v {
+			v[j] = pow
+			pow *= t
+		}
+		return v
+	}
+
+	// Solve the normal equations, AᵀA b = Aᵀy.
+	ata := newSquare(p)
+	aty := make([]float64, p)
+	for _, xy := range data {
+		v := powers(xy.X)
+		for j := range v {
+			for k := range v {
+				ata[j][k] += v[j] * v[k]
+			}
+			aty[j] += v[j] * xy.Y
+		}
+	}
+	l, ok := cholesky(ata)
+	if !ok {
+		return nil, fmt.Errorf("plotter: too few distinct X values to fit polynomial of degree %d", degree)
+	}
+	b := choleskySolve(l, aty)
+
+	ys := make([]float64, len(data))
+	fitted := make([]float64, len(data))
+	for i, xy := range data {
+		ys[i] = xy.Y
+		fitted[i] = dot(powers(xy.X), b)
+	}
+
+	curve := make(XYs, fitSamples)
+	stdErrs := make([]float64, fitSamples)
+	for i := range curve {
+		x := xmin + (xmax-xmin)*float64(i)/(fitSamples-1)
+		v := powers(x)
+		curve[i].X = x
+		curve[i].Y = dot(v, b)
+		stdErrs[i] = math.Sqrt(dot(v, choleskySolve(l, v)))
+	}
+
+	f := &PolynomialFit{
+		Fit:    newFit(curve, stdErrs),
+		Coeffs: make([]float64, p),
+	}
+	f.setResiduals(ys, fitted, float64(p))
+
+	// Expand the polynomial in t into a polynomial in x.
+	for k := 0; k < p; k++ {
+		binom := 1.0
+		for j := 0; j <= k; j++ {
+			f.Coeffs[j] += b[k] * binom * math.Pow(-mid, float64(k-j)) / math.Pow(half, float64(k))
+			binom = binom * float64(k-j) / float64(j+1)
+		}
+	}
+	return f, nil
+}
+
+// String returns the equation of the fitted polynomial
+// followed by its coefficient of determination, suitable
+// for a legend label.
+func (f *PolynomialFit) String() string {
+	var terms []string
+	for k := len(f.Coeffs) - 1; k >= 0; k-- {
+		c := f.Coeffs[k]
+		if c == 0 && len(f.Coeffs) > 1 {
+			continue
+		}
+		sign := "+"
+		if c < 0 {
+			sign = "-"
+		}
+		term := fmt.Sprintf("%.3g", math.Abs(c))
+		switch k {
+		case 0:
+		case 1:
+			term += "x"
+		default:
+			term += fmt.Sprintf("x^%d", k)
+		}
+		if len(terms) == 0 {
+			if sign == "-" {
+				term = "-" + term
+			}
+			terms = append(terms, term)
+			continue
+		}
+		terms = append(terms, sign, term)
+	}
+	return fmt.Sprintf("y = %s, R² = %.3g", strings.Join(terms, " "), f.RSquared)
+}
+
+// NewLoess returns the LOESS curve of the data, fitted by
+// locally weighted quadratic regression. The fit at each X
+// value of the data uses the fraction span of the data with
+// the nearest X values, weighted by the tricube function of
+// their distance. Span is usually between 0.25 and 1; smaller
+// spans follow the data more closely. Where the nearest data
+// have too few distinct X values for a quadratic fit, a fit of
+// lower degree is used. An error is returned if the span is
+// not positive.
+func NewLoess(xys XYer, span float64) (*Fit, error) {
+	return loess(xys, span, 2, 0)
+}
+
+// NewLowess returns the LOWESS curve of the data, the robust
+// locally weighted linear regression of Cleveland. The curve
+// is first fitted as for NewLoess, but with local linear fits,
+// and then refitted three times with the data weighted down
+// by the bisquare function of their residuals, so outliers
+// have little influence on the curve. An error is returned if
+// the span is not positive.
+func NewLowess(xys XYer, span float64) (*Fit, error) {
+	return loess(xys, span, 1, 3)
+}
+
+// loess returns the locally weighted regression of the given
+// degree of the data, with the given number of robustness
+// iterations.
+func loess(xys XYer, span float64, degree, iterations int) (*Fit, error) {
+	if !(span > 0) {
+		return nil, errors.New("plotter: span parameter was not positive")
+	}
+	data, err := sortedXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	n := len(data)
+	q := int(math.Ceil(span * float64(n)))
+	if q < degree+1 {
+		q = degree + 1
+	}
+	if q > n {
+		q = n
+	}
+
+	robust := make([]float64, n)
+	for i := range robust {
+		robust[i] = 1
+	}
+	ys := make([]float64, n)
+	for i, xy := range data {
+		ys[i] = xy.Y
+	}
+	weights := make([][]float64, n)
+	fitted := make([]float64, n)
+	dist := make([]float64, n)
+	for iter := 0; ; iter++ {
+		for i, xy := range data {
+			for j, d := range data {
+				dist[j] = math.Abs(d.X - xy.X)
+			}
+			sorted := append([]float64(nil), dist...)
+			sort.Float64s(sorted)
+			h := sorted[q-1]
+			if span > 1 {
+				h *= span
+			}
+			weights[i] = localWeights(data, xy.X, dist, h, robust, degree)
+			fitted[i] = 0
+			for j, l := range weights[i] {
+				fitted[i] += l * ys[j]
+			}
+		}
+		if iter == iterations {
+			break
+		}
+
+		// Weight the data down by the bisquare
+		// function of their residuals, scaled by
+		// six times the median absolute residual.
+		res := make([]float64, n)
+		for i, y := range ys {
+			res[i] = math.Abs(y - fitted[i])
+		}
+		sort.Float64s(res)
+		s := 6 * QuantileR7.Quantile(res, 0.5)
+		if s == 0 {
+			break
+		}
+		for i, y := range ys {
+			u := (y - fitted[i]) / s
+			if math.Abs(u) >= 1 {
+				robust[i] = 0
+				continue
+			}
+			robust[i] = (1 - u*u) * (1 - u*u)
+		}
+	}
+	return newSmoothFit(data, fitted, weights), nil
+}
+
+// localWeights returns the weights of the Y values of the data
+// giving the value at x of the polynomial of the given degree,
+// fitted by weighted least squares to the data with distances
+// from x in dist, weighted by the tricube function of their
+// distance relative to h and by the robustness weights. If the
+// fit is not determined, a fit of lower degree is used.
+func localWeights(data XYs, x float64, dist []float64, h float64, robust []float64, degree int) []float64 {
+	w := make([]float64, len(data))
+	for j, d := range dist {
+		switch {
+		case h == 0:
+			if d == 0 {
+				w[j] = robust[j]
+			}
+		case d < h:
+			u := d / h
+			w[j] = robust[j] * math.Pow(1-u*u*u, 3)
+		}
+	}
+
+	l := make([]float64, len(data))
+	for ; degree >= 0; degree-- {
+		p := degree + 1
+		xtwx := newSquare(p)
+		for j, xy := range data {
+			if w[j] == 0 {
+				continue
+			}
+			d := xy.X - x
+			for a := 0; a < p; a++ {
+				for b := 0; b < p; b++ {
+					xtwx[a][b] += w[j] * math.Pow(d, float64(a+b))
+				}
+			}
+		}
+		chol, ok := cholesky(xtwx)
+		if !ok {
+			continue
+		}
+
+		// The fitted value is the first coefficient of
+		// the local polynomial, g·Xᵀ W y, where g is the
+		// first row of the inverse of Xᵀ W X.
+		e := make([]float64, p)
+		e[0] = 1
+		g := choleskySolve(chol, e)
+		for j, xy := range data {
+			d := xy.X - x
+			pow := 1.0
+			for a := 0; a < p; a++ {
+				l[j] += w[j] * g[a] * pow
+				pow *= d
+			}
+		}
+		return l
+	}
+
+	// Without any weighted data, the value
+	// at x is the mean of the data at x.
+	var k float64
+	for j, d := range dist {
+		if d == 0 {
+			k++
+			l[j] = 1
+		}
+	}
+	for j := range l {
+		l[j] /= k
+	}
+	return l
+}
+
+// NewMovingAverage returns the centered moving average of
+// the data, the mean of the Y values of each window of the
+// given number of points with consecutive X values. At the
+// ends of the data, the windows are shortened to remain
+// centered. An error is returned if the window is not positive.
+func NewMovingAverage(xys XYer, window int) (*Fit, error) {
+	if window <= 0 {
+		return nil, errors.New("plotter: window parameter was not positive")
+	}
+	data, err := sortedXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	n := len(data)
+	weights := make([][]float64, n)
+	fitted := make([]float64, n)
+	for i := range data {
+		before, after := (window-1)/2, window/2
+		if i < before || n-1-i < after {
+			m := i
+			if n-1-i < m {
+				m = n - 1 - i
+			}
+			before, after = m, m
+		}
+		weights[i] = make([]float64, n)
+		k := float64(before + after + 1)
+		for j := i - before; j <= i+after; j++ {
+			weights[i][j] = 1 / k
+			fitted[i] += data[j].Y / k
+		}
+	}
+	return newSmoothFit(data, fitted, weights), nil
+}
+
+// sortedXYs returns a copy of the data sorted by X value.
+func sortedXYs(xys XYer) (XYs, error) {
+	data, err := CopyXYs(xys)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoData
+	}
+	sort.Stable(xSorter(data))
+	return data, nil
+}
+
+// xSorter sorts XYs by X value.
+type xSorter XYs
+
+func (s xSorter) Len() int           { return len(s) }
+func (s xSorter) Less(i, j int) bool { return s[i].X < s[j].X }
+func (s xSorter) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// newSmoothFit returns the Fit of a linear smoother of the data,
+// sorted by X value, with the given fitted values. The fitted
+// value at the ith point is the sum of the Y values weighted by
+// weights[i]. The curve passes through the fitted value at the
+// first point with each distinct X value.
+func newSmoothFit(data XYs, fitted []float64, weights [][]float64) *Fit {
+	var curve XYs
+	var stdErrs []float64
+	var trace float64
+	for i, xy := range data {
+		trace += weights[i][i]
+		if i > 0 && xy.X == data[i-1].X {
+			continue
+		}
+		var ss float64
+		for _, l := range weights[i] {
+			ss += l * l
+		}
+		curve = append(curve, struct{ X, Y float64 }{X: xy.X, Y: fitted[i]})
+		stdErrs = append(stdErrs, math.Sqrt(ss))
+	}
+	ys := make([]float64, len(data))
+	for i, xy := range data {
+		ys[i] = xy.Y
+	}
+	f := newFit(curve, stdErrs)
+	f.setResiduals(ys, fitted, trace)
+	return f
+}
+
+// newSquare returns an n×n matrix of zeros.
+func newSquare(n int) [][]float64 {
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, n)
+	}
+	return a
+}
+
+// cholesky returns the lower triangular Cholesky factor L of
+// the symmetric matrix a, with a = L Lᵀ, and whether a is
+// positive definite. Only the lower triangle of a is used.
+func cholesky(a [][]float64) (l [][]float64, ok bool) {
+	n := len(a)
+	l = newSquare(n)
+	for j := 0; j < n; j++ {
+		d := a[j][j]
+		for k := 0; k < j; k++ {
+			d -= l[j][k] * l[j][k]
+		}
+		if !(d > 0) {
+			return nil, false
+		}
+		l[j][j] = math.Sqrt(d)
+		for i := j + 1; i < n; i++ {
+			s := a[i][j]
+			for k := 0; k < j; k++ {
+				s -= l[i][k] * l[j][k]
+			}
+			l[i][j] = s / l[j][j]
+		}
+	}
+	return l, true
+}
+
+// choleskySolve returns the solution x of L Lᵀ x = b,
+// where l is the Cholesky factor L returned by cholesky.
+func choleskySolve(l [][]float64, b []float64) []float64 {
+	n := len(l)
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		s := b[i]
+		for k := 0; k < i; k++ {
+			s -= l[i][k] * x[k]
+		}
+		x[i] = s / l[i][i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		s := x[i]
+		for k := i + 1; k < n; k++ {
+			s -= l[k][i] * x[k]
+		}
+		x[i] = s / l[i][i]
+	}
+	return x
+}
+
+// dot returns the dot product of a and b.
+func dot(a, b []float64) float64 {
+	var s float64
+	for i, v := range a {
+		s += v * b[i]
+	}
+	return s
+}
+
+// studentsTQuantile returns the quantile at p, with p in
+// [0.5, 1), of Student's t distribution with nu degrees of
+// freedom, which need not be an integer. The quantile t is
+// found by bisection from the regularized incomplete beta
+// function, as P(T > t) = I_x(nu/2, 1/2)/2 with x = nu/(nu+t²).
+func studentsTQuantile(p, nu float64) float64 {
+	if p == 0.5 {
+		return 0
+	}
+	// I_x is increasing in x, and x decreases with t.
+	target := 2 * (1 - p)
+	lo, hi := 0.0, 1.0
+	for i := 0; i < 200 && lo < hi; i++ {
+		mid := (lo + hi) / 2
+		if mid == lo || mid == hi {
+			break
+		}
+		if regIncBeta(nu/2, 0.5, mid) < target {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	x := (lo + hi) / 2
+	return math.Sqrt(nu * (1 - x) / x)
+}
+
+// regIncBeta returns the regularized incomplete beta function
+// I_x(a, b) for x in [0, 1], evaluated by the continued fraction
+// of Lentz's method.
+func regIncBeta(a, b, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0
+	case x >= 1:
+		return 1
+	}
+	lab, _ := math.Lgamma(a + b)
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	front := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log1p(-x))
+	// The continued fraction converges rapidly
+	// for x < (a+1)/(a+b+2); otherwise use the
+	// symmetry I_x(a, b) = 1 - I_{1-x}(b, a).
+	if x > (a+1)/(a+b+2) {
+		return 1 - front*betaFraction(b, a, 1-x)/b
+	}
+	return front * betaFraction(a, b, x) / a
+}
+
+// betaFraction returns the continued fraction
+// for the incomplete beta function.
+func betaFraction(a, b, x float64) float64 {
+	const (
+		eps  = 1e-16
+		tiny = 1e-300
+	)
+	c, d := 1.0, 1-(a+b)*x/(a+1)
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= 300; m++ {
+		m := float64(m)
+		// Even step.
+		num := m * (b - m) * x / ((a + 2*m - 1) * (a + 2*m))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+		// Odd step.
+		num = -(a + m) * (a + b + m) * x / ((a + 2*m) * (a + 2*m + 1))
+		d = 1 + num*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + num/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return h
+}
@@ -0,0 +1,227 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/gonum/stat/distuv"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+)
+
+// ExampleNewLinearFit draws a straight line fitted to noisy
+// data with its 95% confidence band, and a LOWESS curve
+// following the curvature the straight line misses.
+func ExampleNewLinearFit() {
+	rnd := rand.New(rand.NewSource(1))
+	data := make(XYs, 60)
+	for i := range data {
+		x := 10 * rnd.Float64()
+		data[i].X = x
+		data[i].Y = 2 + 0.5*x + math.Sin(x) + rnd.NormFloat64()/2
+	}
+	s, err := NewScatter(data)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.GlyphStyle.Color = color.Gray{Y: 96}
+
+	lin, err := NewLinearFit(data)
+	if err != nil {
+		log.Panic(err)
+	}
+	lin.Confidence = 0.95
+	lin.LineStyle.Color = color.RGBA{B: 255, A: 255}
+
+	smooth, err := NewLowess(data, 0.3)
+	if err != nil {
+		log.Panic(err)
+	}
+	smooth.LineStyle.Color = color.RGBA{R: 255, A: 255}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Regression"
+	p.Add(lin, smooth, s)
+	p.Legend.Add(lin.String(), lin)
+	p.Legend.Add("LOWESS", smooth)
+	p.Legend.Top = true
+	p.Legend.Left = true
+
+	err = p.Save(300, 200, "testdata/regression.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestNewLinearFit(t *testing.T) {
+	cmpimg.CheckPlot(ExampleNewLinearFit, t, "regression.png")
+}
+
+func TestPolynomialFit(t *testing.T) {
+	var data XYs
+	for x := -3.0; x <= 5; x++ {
+		data = append(data, struct{ X, Y float64 }{X: x, Y: 1 - 2*x + 0.5*x*x})
+	}
+	f, err := NewPolynomialFit(data, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []float64{1, -2, 0.5}
+	for i, c := range f.Coeffs {
+		if math.Abs(c-want[i]) > 1e-9 {
+			t.Errorf("unexpected coefficient %d: got:%v want:%v", i, c, want[i])
+		}
+	}
+	if math.Abs(f.RSquared-1) > 1e-12 {
+		t.Errorf("unexpected R²: got:%v want:1", f.RSquared)
+	}
+	if got, want := f.String(), "y = 0.5x^2 - 2x + 1, R² = 1"; got != want {
+		t.Errorf("unexpected string: got:%q want:%q", got, want)
+	}
+	xmin, xmax, _, _ := f.DataRange()
+	if xmin != -3 || xmax != 5 {
+		t.Errorf("unexpected X range: got:[%v, %v] want:[-3, 5]", xmin, xmax)
+	}
+
+	_, err = NewPolynomialFit(XYs{{X: 1, Y: 1}, {X: 1, Y: 2}}, 1)
+	if err == nil {
+		t.Errorf("expected error for a single distinct X value")
+	}
+}
+
+func TestLinearFitBand(t *testing.T) {
+	data := XYs{{X: 0, Y: 1}, {X: 1, Y: 3}, {X: 2, Y: 2}, {X: 3, Y: 5}, {X: 4, Y: 4}}
+	f, err := NewLinearFit(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The least squares line is y = 1.4 + 0.8x, with
+	// residuals -0.4, 0.8, -1, 1.2, -0.6.
+	if math.Abs(f.Coeffs[0]-1.4) > 1e-12 || math.Abs(f.Coeffs[1]-0.8) > 1e-12 {
+		t.Errorf("unexpected coefficients: got:%v want:[1.4 0.8]", f.Coeffs)
+	}
+	const sse, sst = 3.6, 10
+	if want := 1 - sse/sst; math.Abs(f.RSquared-want) > 1e-12 {
+		t.Errorf("unexpected R²: got:%v want:%v", f.RSquared, want)
+	}
+	if want := math.Sqrt(sse / 3); math.Abs(f.StdErr-want) > 1e-12 {
+		t.Errorf("unexpected standard error: got:%v want:%v", f.StdErr, want)
+	}
+
+	if lower, _ := f.Band(); lower != nil {
+		t.Errorf("unexpected band without confidence level")
+	}
+	f.Confidence = 0.95
+	lower, upper := f.Band()
+	if len(lower) != len(f.XYs) {
+		t.Fatalf("unexpected band length: got:%d want:%d", len(lower), len(f.XYs))
+	}
+	// At the mean X value the half width of the band is
+	// t(0.975, 3) s/√n, with t(0.975, 3) = 3.182446.
+	var mid int
+	for i, xy := range f.XYs {
+		if math.Abs(xy.X-2) < math.Abs(f.XYs[mid].X-2) {
+			mid = i
+		}
+	}
+	want := 3.182446 * f.StdErr / math.Sqrt(5)
+	if got := (upper[mid] - lower[mid]) / 2; math.Abs(got-want) > 1e-2 {
+		t.Errorf("unexpected band half width at mean: got:%v want:%v", got, want)
+	}
+	if upper[0]-lower[0] <= upper[mid]-lower[mid] {
+		t.Errorf("band not wider at the end than at the mean")
+	}
+}
+
+func TestLinearFitExact(t *testing.T) {
+	f, err := NewLinearFit(XYs{{X: 0, Y: 1}, {X: 1, Y: 3}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !math.IsNaN(f.StdErr) {
+		t.Errorf("unexpected standard error without residual degrees of freedom: got:%v want:NaN", f.StdErr)
+	}
+	f.Confidence = 0.95
+	if lower, _ := f.Band(); lower != nil {
+		t.Errorf("unexpected band without residual degrees of freedom")
+	}
+}
+
+func TestStudentsTQuantile(t *testing.T) {
+	for _, nu := range []float64{1, 2.5, 3, 10, 57.3, 1000} {
+		for _, p := range []float64{0.5, 0.6, 0.9, 0.975, 0.995, 0.9999} {
+			got := studentsTQuantile(p, nu)
+			want := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: nu}.Quantile(p)
+			if math.Abs(got-want) > 1e-9*math.Max(1, want) {
+				t.Errorf("unexpected quantile at %v with %v degrees of freedom: got:%v want:%v", p, nu, got, want)
+			}
+		}
+	}
+}
+
+func TestLowess(t *testing.T) {
+	var data XYs
+	for x := 0.0; x < 20; x++ {
+		noise := math.Sin(3 * x)
+		data = append(data, struct{ X, Y float64 }{X: x, Y: 3 + 2*x + noise})
+	}
+	data[10].Y += 20
+
+	f, err := NewLowess(data, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(f.XYs) != len(data) {
+		t.Fatalf("unexpected number of points: got:%d want:%d", len(f.XYs), len(data))
+	}
+	for i, xy := range f.XYs {
+		if want := 3 + 2*xy.X; math.Abs(xy.Y-want) > 0.5 {
+			t.Errorf("outlier not ignored at %d: got:%v want:%v", i, xy.Y, want)
+		}
+	}
+
+	l, err := NewLoess(data, 0.5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.XYs[10].Y < 25 {
+		t.Errorf("unexpected LOESS value at outlier: got:%v want more than 25", l.XYs[10].Y)
+	}
+
+	_, err = NewLowess(data, 0)
+	if err == nil {
+		t.Errorf("expected error for zero span")
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	data := XYs{{X: 4, Y: 8}, {X: 0, Y: 0}, {X: 1, Y: 2}, {X: 3, Y: 4}, {X: 2, Y: 10}}
+	for _, test := range []struct {
+		window int
+		want   []float64
+	}{
+		{window: 1, want: []float64{0, 2, 10, 4, 8}},
+		{window: 3, want: []float64{0, 4, 16.0 / 3, 22.0 / 3, 8}},
+		{window: 4, want: []float64{0, 4, 6, 22.0 / 3, 8}},
+	} {
+		f, err := NewMovingAverage(data, test.window)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for i, xy := range f.XYs {
+			if xy.X != float64(i) || math.Abs(xy.Y-test.want[i]) > 1e-12 {
+				t.Errorf("unexpected window %d average %d: got:%v want:{%d %v}", test.window, i, xy, i, test.want[i])
+			}
+		}
+	}
+}
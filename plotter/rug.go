@@ -0,0 +1,99 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Rug implements the Plotter interface, drawing a short
+// tick at each value along an edge of the data area, to
+// show the distribution of the values along an axis.
+type Rug struct {
+	// Values holds the values drawn by the rug.
+	Values
+
+	// Horizontal specifies that the values are Y values,
+	// drawn as horizontal ticks along the left edge of
+	// the data area. Otherwise they are X values, drawn
+	// as vertical ticks along the bottom edge.
+	Horizontal bool
+
+	// Far specifies that the ticks are drawn
+	// along the opposite edge, the top edge or
+	// the right edge of the data area.
+	Far bool
+
+	// Length is the length of the ticks.
+	Length vg.Length
+
+	// LineStyle is the style of the ticks.
+	draw.LineStyle
+}
+
+// NewRug returns a Rug of the given values,
+// drawn as vertical ticks along the X axis.
+func NewRug(vs Valuer) (*Rug, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	return &Rug{
+		Values:    values,
+		Length:    vg.Points(5),
+		LineStyle: DefaultLineStyle,
+	}, nil
+}
+
+// Plot implements the plot.Plotter interface.
+func (r *Rug) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	for _, v := range r.Values {
+		var tick []vg.Point
+		if r.Horizontal {
+			y := trY(v)
+			if !c.ContainsY(y) {
+				continue
+			}
+			x, dir := c.Min.X, vg.Length(1)
+			if r.Far {
+				x, dir = c.Max.X, -1
+			}
+			tick = []vg.Point{{X: x, Y: y}, {X: x + dir*r.Length, Y: y}}
+		} else {
+			x := trX(v)
+			if !c.ContainsX(x) {
+				continue
+			}
+			y, dir := c.Min.Y, vg.Length(1)
+			if r.Far {
+				y, dir = c.Max.Y, -1
+			}
+			tick = []vg.Point{{X: x, Y: y}, {X: x, Y: y + dir*r.Length}}
+		}
+		c.StrokeLines(r.LineStyle, tick)
+	}
+}
+
+// DataRange implements the plot.DataRanger interface,
+// returning the range of the values along the axis of
+// the rug, and an empty range along the other axis.
+func (r *Rug) DataRange() (xmin, xmax, ymin, ymax float64) {
+	min, max := Range(r.Values)
+	if r.Horizontal {
+		return math.Inf(1), math.Inf(-1), min, max
+	}
+	return min, max, math.Inf(1), math.Inf(-1)
+}
+
+// Thumbnail implements the plot.Thumbnailer interface.
+func (r *Rug) Thumbnail(c *draw.Canvas) {
+	x := c.Center().X
+	c.StrokeLine2(r.LineStyle, x, c.Min.Y, x, c.Max.Y)
+}
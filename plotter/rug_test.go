@@ -0,0 +1,76 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func TestRug(t *testing.T) {
+	r, err := NewRug(Values{1, 4, 2, 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xmin, xmax, ymin, ymax := r.DataRange()
+	if xmin != 1 || xmax != 30 || !math.IsInf(ymin, 1) || !math.IsInf(ymax, -1) {
+		t.Errorf("unexpected data range: got:%v", []float64{xmin, xmax, ymin, ymax})
+	}
+	r.Horizontal = true
+	xmin, xmax, ymin, ymax = r.DataRange()
+	if !math.IsInf(xmin, 1) || !math.IsInf(xmax, -1) || ymin != 1 || ymax != 30 {
+		t.Errorf("unexpected horizontal data range: got:%v", []float64{xmin, xmax, ymin, ymax})
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.X.Min, p.X.Max = 0, 10
+	p.Y.Min, p.Y.Max = 0, 10
+	for _, test := range []struct {
+		horizontal, far bool
+		want            [][2]vg.Point
+	}{
+		{horizontal: false, far: false, want: [][2]vg.Point{{{X: 10, Y: 0}, {X: 10, Y: 5}}, {{X: 40, Y: 0}, {X: 40, Y: 5}}, {{X: 20, Y: 0}, {X: 20, Y: 5}}}},
+		{horizontal: false, far: true, want: [][2]vg.Point{{{X: 10, Y: 100}, {X: 10, Y: 95}}, {{X: 40, Y: 100}, {X: 40, Y: 95}}, {{X: 20, Y: 100}, {X: 20, Y: 95}}}},
+		{horizontal: true, far: false, want: [][2]vg.Point{{{X: 0, Y: 10}, {X: 5, Y: 10}}, {{X: 0, Y: 40}, {X: 5, Y: 40}}, {{X: 0, Y: 20}, {X: 5, Y: 20}}}},
+	} {
+		r.Horizontal = test.horizontal
+		r.Far = test.far
+		var rec recorder.Canvas
+		c := draw.NewCanvas(&rec, 100, 100)
+		r.Plot(c, p)
+		var got [][2]vg.Point
+		for _, a := range rec.Actions {
+			s, ok := a.(*recorder.Stroke)
+			if !ok {
+				continue
+			}
+			var pts []vg.Point
+			for _, comp := range s.Path {
+				pts = append(pts, comp.Pos)
+			}
+			if len(pts) != 2 {
+				t.Fatalf("unexpected tick path: %v", s.Path)
+			}
+			got = append(got, [2]vg.Point{pts[0], pts[1]})
+		}
+		if len(got) != len(test.want) {
+			t.Errorf("unexpected ticks for horizontal=%t far=%t: got:%v want:%v", test.horizontal, test.far, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("unexpected tick %d for horizontal=%t far=%t: got:%v want:%v", i, test.horizontal, test.far, got[i], test.want[i])
+			}
+		}
+	}
+}
@@ -19,6 +19,21 @@ type Scatter struct {
 	// GlyphStyle is the style of the glyphs drawn
 	// at each point.
 	draw.GlyphStyle
+
+	// GlyphStyleFunc, if not nil, returns the style
+	// of the glyph drawn at the ith point, in place
+	// of GlyphStyle.
+	GlyphStyleFunc func(i int) draw.GlyphStyle
+
+	// ColorMapping, SizeMapping and ShapeMapping,
+	// if not nil, map a value of each point to the
+	// color, radius and shape of its glyph. They
+	// are applied after GlyphStyleFunc. Points
+	// beyond the end of the values of a mapping
+	// are drawn without it.
+	ColorMapping *ColorMapping
+	SizeMapping  *SizeMapping
+	ShapeMapping *ShapeMapping
 }
 
 // NewScatter returns a Scatter that uses the
@@ -38,9 +53,30 @@ func NewScatter(xys XYer) (*Scatter, error) {
 // interface.
 func (pts *Scatter) Plot(c draw.Canvas, plt *plot.Plot) {
 	trX, trY := plt.Transforms(&c)
-	for _, p := range pts.XYs {
-		c.DrawGlyph(pts.GlyphStyle, vg.Point{X: trX(p.X), Y: trY(p.Y)})
+	for i, p := range pts.XYs {
+		c.DrawGlyph(pts.glyphStyle(i), vg.Point{X: trX(p.X), Y: trY(p.Y)})
+	}
+}
+
+// glyphStyle returns the style of the glyph
+// drawn at the ith point.
+func (pts *Scatter) glyphStyle(i int) draw.GlyphStyle {
+	sty := pts.GlyphStyle
+	if pts.GlyphStyleFunc != nil {
+		sty = pts.GlyphStyleFunc(i)
+	}
+	if m := pts.ColorMapping; m != nil && i < len(m.Values) {
+		if col := m.color(m.Values[i]); col != nil {
+			sty.Color = col
+		}
+	}
+	if m := pts.SizeMapping; m != nil && i < len(m.Values) {
+		sty.Radius = m.radius(m.Values[i])
+	}
+	if m := pts.ShapeMapping; m != nil && i < len(m.Categories) {
+		sty.Shape = m.shape(m.Categories[i], sty.Shape)
 	}
+	return sty
 }
 
 // DataRange returns the minimum and maximum
@@ -57,7 +93,7 @@ func (pts *Scatter) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
 	for i, p := range pts.XYs {
 		bs[i].X = plt.X.Norm(p.X)
 		bs[i].Y = plt.Y.Norm(p.Y)
-		bs[i].Rectangle = pts.GlyphStyle.Rectangle()
+		bs[i].Rectangle = pts.glyphStyle(i).Rectangle()
 	}
 	return bs
 }
@@ -0,0 +1,321 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ColorMapping maps a value of each point of a Scatter
+// to the color of its glyph through a ColorMap. Values
+// outside the range of the ColorMap are given the color
+// of the nearest bound.
+type ColorMapping struct {
+	// Values holds the value of each point.
+	Values
+
+	// ColorMap maps the values to colors.
+	ColorMap palette.ColorMap
+}
+
+// color returns the color of the value v, or nil
+// if the ColorMap has no color for it.
+func (m *ColorMapping) color(v float64) color.Color {
+	return clampedColor(m.ColorMap, v)
+}
+
+// ColorBar returns a ColorBar of the ColorMap, to
+// be drawn in a separate plot as a key to the colors.
+func (m *ColorMapping) ColorBar() *ColorBar {
+	return &ColorBar{ColorMap: m.ColorMap}
+}
+
+// Thumbnail implements the plot.Thumbnailer interface,
+// drawing the colors of the ColorMap from its minimum
+// on the left to its maximum on the right, as a small
+// color bar.
+func (m *ColorMapping) Thumbnail(c *draw.Canvas) {
+	fillColorStrip(c, m.ColorMap, c.Min.Y, c.Max.Y)
+}
+
+// clampedColor returns the color of the value v in
+// the ColorMap, giving values outside its range the
+// color of the nearest bound, or nil if the ColorMap
+// has no color for v.
+func clampedColor(cm palette.ColorMap, v float64) color.Color {
+	v = math.Max(cm.Min(), math.Min(v, cm.Max()))
+	col, err := cm.At(v)
+	if err != nil {
+		return nil
+	}
+	return col
+}
+
+// fillColorStrip fills the width of the canvas between
+// ymin and ymax with the colors of the ColorMap, from
+// its minimum on the left to its maximum on the right.
+func fillColorStrip(c *draw.Canvas, cm palette.ColorMap, ymin, ymax vg.Length) {
+	n := int((c.Max.X - c.Min.X).Points())
+	if n < 2 {
+		n = 2
+	}
+	min, max := cm.Min(), cm.Max()
+	w := (c.Max.X - c.Min.X) / vg.Length(n)
+	for i := 0; i < n; i++ {
+		col := clampedColor(cm, min+(max-min)*(float64(i)+0.5)/float64(n))
+		if col == nil {
+			continue
+		}
+		x0 := c.Min.X + vg.Length(i)*w
+		pts := []vg.Point{
+			{X: x0, Y: ymin},
+			{X: x0, Y: ymax},
+			{X: x0 + w, Y: ymax},
+			{X: x0 + w, Y: ymin},
+		}
+		c.FillPolygon(col, c.ClipPolygonY(pts))
+	}
+}
+
+// SizeMapping maps a value of each point of a Scatter
+// to the radius of its glyph. The radius is interpolated
+// linearly from MinRadius at Min to MaxRadius at Max,
+// and values outside the range are given the radius of
+// the nearest bound.
+type SizeMapping struct {
+	// Values holds the value of each point.
+	Values
+
+	// Min and Max are the values given
+	// the minimum and maximum radii.
+	Min, Max float64
+
+	// MinRadius and MaxRadius are the
+	// minimum and maximum glyph radii.
+	MinRadius, MaxRadius vg.Length
+}
+
+// radius returns the radius of the value v.
+func (m *SizeMapping) radius(v float64) vg.Length {
+	rng := m.MaxRadius - m.MinRadius
+	if m.Max == m.Min {
+		return rng/2 + m.MinRadius
+	}
+	d := (v - m.Min) / (m.Max - m.Min)
+	d = math.Max(0, math.Min(d, 1))
+	return vg.Length(d)*rng + m.MinRadius
+}
+
+// ShapeMapping maps a category of each point of
+// a Scatter to the shape of its glyph.
+type ShapeMapping struct {
+	// Categories holds the category of each
+	// point, a non-negative integer.
+	Categories []int
+
+	// Shapes holds the glyph shapes of the
+	// categories. Categories beyond the end
+	// of Shapes reuse them cyclically. If
+	// Shapes is empty, or a category is
+	// negative, the glyph keeps its shape.
+	Shapes []draw.GlyphDrawer
+
+	// Names holds the names of the categories,
+	// used to label their legend entries. If
+	// Names is nil, the categories are labeled
+	// by number.
+	Names []string
+}
+
+// shape returns the shape of the category k,
+// or def if the category has no shape.
+func (m *ShapeMapping) shape(k int, def draw.GlyphDrawer) draw.GlyphDrawer {
+	if k < 0 || len(m.Shapes) == 0 {
+		return def
+	}
+	return m.Shapes[k%len(m.Shapes)]
+}
+
+// DefaultShapes is the set of glyph shapes
+// used by the Scatter ShapeBy method.
+var DefaultShapes = []draw.GlyphDrawer{
+	draw.CircleGlyph{},
+	draw.BoxGlyph{},
+	draw.TriangleGlyph{},
+	draw.DiamondGlyph{},
+	draw.RingGlyph{},
+	draw.SquareGlyph{},
+	draw.PyramidGlyph{},
+	draw.CrossGlyph{},
+}
+
+// ColorBy sets the ColorMapping of the Scatter to map
+// the values, one for each point, to colors through the
+// ColorMap.
+//
+// ColorBy sets the range of the ColorMap, which is not
+// copied, to the range of the values, replacing any range
+// set by the caller and changing the colors of other
+// plotters sharing the ColorMap. To map another range,
+// set the range of the ColorMap after calling ColorBy.
+func (pts *Scatter) ColorBy(vs Valuer, cm palette.ColorMap) error {
+	if cm == nil {
+		return errors.New("plotter: nil ColorMap")
+	}
+	values, err := pts.mappedValues(vs)
+	if err != nil {
+		return err
+	}
+	min, max := Range(values)
+	if min == max {
+		min, max = min-0.5, max+0.5
+	}
+	cm.SetMin(min)
+	cm.SetMax(max)
+	pts.ColorMapping = &ColorMapping{Values: values, ColorMap: cm}
+	return nil
+}
+
+// SizeBy sets the SizeMapping of the Scatter to map
+// the values, one for each point, to glyph radii from
+// minRadius at the smallest value to maxRadius at the
+// largest.
+func (pts *Scatter) SizeBy(vs Valuer, minRadius, maxRadius vg.Length) error {
+	if minRadius < 0 || maxRadius < minRadius {
+		return errors.New("plotter: invalid radius range")
+	}
+	values, err := pts.mappedValues(vs)
+	if err != nil {
+		return err
+	}
+	min, max := Range(values)
+	pts.SizeMapping = &SizeMapping{
+		Values:    values,
+		Min:       min,
+		Max:       max,
+		MinRadius: minRadius,
+		MaxRadius: maxRadius,
+	}
+	return nil
+}
+
+// ShapeBy sets the ShapeMapping of the Scatter to map
+// the categories, one for each point, to the glyph
+// shapes of DefaultShapes. The names of the categories
+// may be nil.
+func (pts *Scatter) ShapeBy(categories []int, names []string) error {
+	if len(categories) != len(pts.XYs) {
+		return errors.New("plotter: number of categories does not match number of points")
+	}
+	for _, k := range categories {
+		if k < 0 {
+			return errors.New("plotter: negative category")
+		}
+		if names != nil && k >= len(names) {
+			return fmt.Errorf("plotter: no name for category %d", k)
+		}
+	}
+	pts.ShapeMapping = &ShapeMapping{
+		Categories: append([]int(nil), categories...),
+		Shapes:     DefaultShapes,
+		Names:      names,
+	}
+	return nil
+}
+
+// mappedValues returns a copy of the values,
+// checking that there is one for each point.
+func (pts *Scatter) mappedValues(vs Valuer) (Values, error) {
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != len(pts.XYs) {
+		return nil, errors.New("plotter: number of values does not match number of points")
+	}
+	return values, nil
+}
+
+// SizeThumbnailers returns a label and a thumbnailer for
+// representative values of the SizeMapping, chosen as the
+// major ticks of its range, to be used to add legend entries
+// showing the glyph radii. The glyphs are drawn in the
+// GlyphStyle of the Scatter. No entries are returned if the
+// Scatter has no SizeMapping.
+func (pts *Scatter) SizeThumbnailers() (legendLabels []string, thumbnailers []plot.Thumbnailer) {
+	m := pts.SizeMapping
+	if m == nil {
+		return nil, nil
+	}
+	add := func(label string, v float64) {
+		sty := pts.GlyphStyle
+		sty.Radius = m.radius(v)
+		legendLabels = append(legendLabels, label)
+		thumbnailers = append(thumbnailers, glyphThumbnailer{sty})
+	}
+	if m.Min == m.Max {
+		add(strconv.FormatFloat(m.Min, 'g', -1, 64), m.Min)
+		return legendLabels, thumbnailers
+	}
+	min, max := math.Min(m.Min, m.Max), math.Max(m.Min, m.Max)
+	for _, t := range (plot.DefaultTicks{}).Ticks(min, max) {
+		if t.IsMinor() || t.Value < min || t.Value > max {
+			continue
+		}
+		add(t.Label, t.Value)
+	}
+	return legendLabels, thumbnailers
+}
+
+// ShapeThumbnailers returns a label and a thumbnailer for
+// each category of the ShapeMapping, to be used to add
+// legend entries for the categories. The glyphs are drawn
+// in the GlyphStyle of the Scatter. No entries are returned
+// if the Scatter has no ShapeMapping.
+func (pts *Scatter) ShapeThumbnailers() (legendLabels []string, thumbnailers []plot.Thumbnailer) {
+	m := pts.ShapeMapping
+	if m == nil {
+		return nil, nil
+	}
+	n := len(m.Names)
+	if m.Names == nil {
+		for _, k := range m.Categories {
+			if k >= n {
+				n = k + 1
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		label := strconv.Itoa(k)
+		if m.Names != nil {
+			label = m.Names[k]
+		}
+		sty := pts.GlyphStyle
+		sty.Shape = m.shape(k, sty.Shape)
+		legendLabels = append(legendLabels, label)
+		thumbnailers = append(thumbnailers, glyphThumbnailer{sty})
+	}
+	return legendLabels, thumbnailers
+}
+
+// glyphThumbnailer draws a glyph in
+// the center of the thumbnail.
+type glyphThumbnailer struct {
+	draw.GlyphStyle
+}
+
+// Thumbnail implements the plot.Thumbnailer interface.
+func (t glyphThumbnailer) Thumbnail(c *draw.Canvas) {
+	c.DrawGlyph(t.GlyphStyle, c.Center())
+}
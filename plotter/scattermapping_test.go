@@ -0,0 +1,190 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// ExampleScatter_ColorBy draws cities as points colored
+// by their mean temperature and sized by their population,
+// with a color bar and size legend entries in the legend.
+func ExampleScatter_ColorBy() {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 40
+	pts := make(XYs, n)
+	temperature := make(Values, n)
+	population := make(Values, n)
+	for i := range pts {
+		pts[i].X = 360*rnd.Float64() - 180
+		pts[i].Y = 140*rnd.Float64() - 70
+		temperature[i] = 30 - 0.4*math.Abs(pts[i].Y) + 3*rnd.NormFloat64()
+		population[i] = 10 * rnd.ExpFloat64()
+	}
+
+	s, err := NewScatter(pts)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.GlyphStyle.Shape = draw.CircleGlyph{}
+	err = s.ColorBy(temperature, moreland.SmoothBlueRed())
+	if err != nil {
+		log.Panic(err)
+	}
+	err = s.SizeBy(population, vg.Points(1), vg.Points(8))
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Cities"
+	p.X.Label.Text = "Longitude"
+	p.Y.Label.Text = "Latitude"
+	p.Add(s)
+	p.Legend.Add("°C", s.ColorMapping)
+	labels, thumbs := s.SizeThumbnailers()
+	for i, l := range labels {
+		p.Legend.Add(l+" M", thumbs[i])
+	}
+
+	err = p.Save(300, 200, "testdata/scatterMapping.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestScatter_ColorBy(t *testing.T) {
+	cmpimg.CheckPlot(ExampleScatter_ColorBy, t, "scatterMapping.png")
+}
+
+func TestScatterMapping(t *testing.T) {
+	s, err := NewScatter(XYs{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s.GlyphStyleFunc = func(i int) draw.GlyphStyle {
+		sty := s.GlyphStyle
+		sty.Radius = vg.Length(i + 1)
+		return sty
+	}
+	for i := range s.XYs {
+		if got, want := s.glyphStyle(i).Radius, vg.Length(i+1); got != want {
+			t.Errorf("unexpected radius from style function %d: got:%v want:%v", i, got, want)
+		}
+	}
+
+	cm := moreland.SmoothBlueRed()
+	err = s.ColorBy(Values{10, 30, 20}, cm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cm.Min() != 10 || cm.Max() != 30 {
+		t.Errorf("unexpected ColorMap range: got:[%v, %v] want:[10, 30]", cm.Min(), cm.Max())
+	}
+	for i, v := range []float64{10, 30, 20} {
+		want, err := cm.At(v)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := s.glyphStyle(i).Color; !sameColor(got, want) {
+			t.Errorf("unexpected color %d: got:%v want:%v", i, got, want)
+		}
+	}
+	if got, want := s.ColorMapping.color(50), s.ColorMapping.color(30); !sameColor(got, want) {
+		t.Errorf("value above range not clamped: got:%v want:%v", got, want)
+	}
+
+	err = s.SizeBy(Values{5, 1, 3}, 2, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, want := range []vg.Length{10, 2, 6} {
+		if got := s.glyphStyle(i).Radius; got != want {
+			t.Errorf("unexpected radius %d: got:%v want:%v", i, got, want)
+		}
+	}
+	boxes := s.GlyphBoxes(&plot.Plot{X: plot.Axis{Min: 0, Max: 2, Scale: plot.LinearScale{}}, Y: plot.Axis{Min: 0, Max: 2, Scale: plot.LinearScale{}}})
+	if got := boxes[0].Rectangle.Max.X; got != 10 {
+		t.Errorf("unexpected glyph box size: got:%v want:10", got)
+	}
+
+	err = s.ShapeBy([]int{0, 1, 0}, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := s.glyphStyle(1).Shape.(draw.BoxGlyph); !ok {
+		t.Errorf("unexpected shape: got:%T want:draw.BoxGlyph", s.glyphStyle(1).Shape)
+	}
+	labels, thumbs := s.ShapeThumbnailers()
+	if len(labels) != 2 || labels[0] != "a" || labels[1] != "b" || len(thumbs) != 2 {
+		t.Errorf("unexpected shape legend entries: got:%v", labels)
+	}
+
+	labels, thumbs = s.SizeThumbnailers()
+	want := []string{"1", "2", "3", "4", "5"}
+	if len(labels) != len(want) || len(thumbs) != len(want) {
+		t.Fatalf("unexpected size legend entries: got:%v want:%v", labels, want)
+	}
+	for i, l := range labels {
+		if l != want[i] {
+			t.Errorf("unexpected size legend label %d: got:%q want:%q", i, l, want[i])
+		}
+		if got, want := thumbs[i].(glyphThumbnailer).Radius, vg.Length(2*(i+1)); got != want {
+			t.Errorf("unexpected size legend radius %d: got:%v want:%v", i, got, want)
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		err  error
+	}{
+		{name: "ColorBy", err: s.ColorBy(Values{1, 2}, cm)},
+		{name: "SizeBy", err: s.SizeBy(Values{1, 2, 3}, 5, 1)},
+		{name: "ShapeBy", err: s.ShapeBy([]int{0, -1, 0}, nil)},
+		{name: "ShapeBy names", err: s.ShapeBy([]int{0, 2, 0}, []string{"a", "b"})},
+	} {
+		if test.err == nil {
+			t.Errorf("expected error from %s", test.name)
+		}
+	}
+}
+
+func TestScatterMappingMismatch(t *testing.T) {
+	s, err := NewScatter(XYs{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s.ColorMapping = &ColorMapping{Values: Values{1}, ColorMap: moreland.SmoothBlueRed()}
+	s.SizeMapping = &SizeMapping{Values: Values{1, 2}, Min: 1, Max: 2, MinRadius: 1, MaxRadius: 2}
+	s.ShapeMapping = &ShapeMapping{Categories: []int{0, 1, 2}}
+	for i := range s.XYs {
+		sty := s.glyphStyle(i)
+		if i >= 1 && !sameColor(sty.Color, s.GlyphStyle.Color) {
+			t.Errorf("unexpected color %d without mapped value: got:%v want:%v", i, sty.Color, s.GlyphStyle.Color)
+		}
+		if i >= 2 && sty.Radius != s.GlyphStyle.Radius {
+			t.Errorf("unexpected radius %d without mapped value: got:%v want:%v", i, sty.Radius, s.GlyphStyle.Radius)
+		}
+		if sty.Shape != s.GlyphStyle.Shape {
+			t.Errorf("unexpected shape %d without mapped shapes: got:%T want:%T", i, sty.Shape, s.GlyphStyle.Shape)
+		}
+	}
+	labels, _ := s.ShapeThumbnailers()
+	if len(labels) != 3 {
+		t.Errorf("unexpected number of shape legend entries: got:%d want:3", len(labels))
+	}
+}
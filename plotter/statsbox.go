@@ -0,0 +1,277 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"fmt"
+	"image/color"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// StatField is a set of histogram statistics
+// to be shown in a StatsBox.
+type StatField uint
+
+const (
+	// StatEntries is the number of entries.
+	StatEntries StatField = 1 << iota
+
+	// StatMean is the mean of the entries.
+	StatMean
+
+	// StatRMS is the root mean square deviation
+	// of the entries from their mean.
+	StatRMS
+
+	// StatUnderflow and StatOverflow are the
+	// weights of the underflow and overflow bins.
+	StatUnderflow
+	StatOverflow
+
+	// StatIntegral is the sum of the weights of
+	// the entries within the bins.
+	StatIntegral
+
+	// DefaultStatFields is the set of statistics
+	// shown by default.
+	DefaultStatFields = StatEntries | StatMean | StatRMS | StatUnderflow | StatOverflow
+)
+
+// StatRow is a row of a StatsBox, holding the name
+// of a value and the value, with its uncertainty if
+// Error is not zero.
+type StatRow struct {
+	Name         string
+	Value, Error float64
+
+	// Format is the fmt format of the value and
+	// its uncertainty. If Format is empty, the
+	// Format of the StatsBox is used.
+	Format string
+}
+
+// FitParameter is a fitted parameter of a
+// function, with its uncertainty.
+type FitParameter struct {
+	Name         string
+	Value, Error float64
+}
+
+// StatsBox implements the Plotter interface, drawing a
+// box of named values, such as the statistics of a
+// histogram, in a corner of the plot's data area.
+type StatsBox struct {
+	// Title is the title drawn at the top of the box.
+	// If Title is empty, no title is drawn.
+	Title string
+
+	// Rows are the rows of the box, drawn with the
+	// names left aligned and the values right aligned.
+	Rows []StatRow
+
+	// Format is the fmt format used for the values
+	// of the rows that do not have their own format.
+	// The values are formatted when the box is drawn.
+	Format string
+
+	// TextStyle is the style of the text in the box.
+	draw.TextStyle
+
+	// LineStyle is the style of the box outline.
+	// If the width is zero, no outline is drawn.
+	LineStyle draw.LineStyle
+
+	// FillColor is the color used to fill the box.
+	// If FillColor is nil, the box is not filled.
+	FillColor color.Color
+
+	// Padding is the space between the outline
+	// of the box and its text.
+	Padding vg.Length
+
+	// Top and Left specify the corner of the data
+	// area in which the box is placed, as for the
+	// plot.Legend.
+	Top, Left bool
+
+	// XOffs and YOffs are added to the box's
+	// final position.
+	XOffs, YOffs vg.Length
+
+	// fit is the function whose parameters were
+	// added to the box, starting at fitRow.
+	fit    *Function
+	fitRow int
+}
+
+// NewStatsBox returns a StatsBox showing the given fields of
+// the statistics of h, placed in the top right corner of the
+// data area. Values are formatted with the Format of the box,
+// %.4g by default, except for the number of entries.
+func NewStatsBox(h *Histogram, fields StatField) (*StatsBox, error) {
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	b := &StatsBox{
+		Format:    "%.4g",
+		TextStyle: draw.TextStyle{Font: fnt},
+		LineStyle: DefaultLineStyle,
+		FillColor: color.White,
+		Padding:   vg.Points(3),
+		Top:       true,
+	}
+
+	st := h.Stats()
+	for _, f := range []struct {
+		field StatField
+		name  string
+		value float64
+	}{
+		{field: StatEntries, name: "Entries", value: float64(st.Entries)},
+		{field: StatMean, name: "Mean", value: st.Mean},
+		{field: StatRMS, name: "RMS", value: st.RMS},
+		{field: StatUnderflow, name: "Underflow", value: st.Underflow},
+		{field: StatOverflow, name: "Overflow", value: st.Overflow},
+		{field: StatIntegral, name: "Integral", value: st.Integral},
+	} {
+		if fields&f.field == 0 {
+			continue
+		}
+		r := StatRow{Name: f.name, Value: f.value}
+		if f.field == StatEntries {
+			r.Format = "%.0f"
+		}
+		b.Rows = append(b.Rows, r)
+	}
+	return b, nil
+}
+
+// AddFit adds a row for each of the given parameters of the
+// fitted function f, formatted as value ± error. A sample of
+// the line style of f is drawn before the first parameter.
+func (b *StatsBox) AddFit(f *Function, params ...FitParameter) {
+	if len(params) == 0 {
+		return
+	}
+	b.fit = f
+	b.fitRow = len(b.Rows)
+	for _, p := range params {
+		b.Rows = append(b.Rows, StatRow{Name: p.Name, Value: p.Value, Error: p.Error})
+	}
+}
+
+// text returns the formatted value of the row r,
+// followed by ± and its uncertainty if it has one.
+func (b *StatsBox) text(r StatRow) string {
+	format := r.Format
+	if format == "" {
+		format = b.Format
+	}
+	v := fmt.Sprintf(format, r.Value)
+	if r.Error != 0 {
+		v += " ± " + fmt.Sprintf(format, r.Error)
+	}
+	return v
+}
+
+// statsBoxGap is the minimum space between
+// the names and values of a StatsBox.
+const statsBoxGap = vg.Length(8)
+
+// Plot implements the plot.Plotter interface.
+func (b *StatsBox) Plot(c draw.Canvas, plt *plot.Plot) {
+	if len(b.Rows) == 0 && b.Title == "" {
+		return
+	}
+
+	values := make([]string, len(b.Rows))
+	var nameWidth, valueWidth vg.Length
+	for i, r := range b.Rows {
+		values[i] = b.text(r)
+		nameWidth = maxLength(nameWidth, b.TextStyle.Width(r.Name))
+		valueWidth = maxLength(valueWidth, b.TextStyle.Width(values[i]))
+	}
+	var thumbWidth vg.Length
+	if b.fit != nil {
+		thumbWidth = b.TextStyle.Font.Size * 1.5
+	}
+	width := thumbWidth + nameWidth + statsBoxGap + valueWidth
+	width = maxLength(width, b.TextStyle.Width(b.Title))
+	width += 2 * b.Padding
+
+	rowHeight := b.TextStyle.Height("0")
+	rows := len(b.Rows)
+	if b.Title != "" {
+		rows++
+	}
+	height := vg.Length(rows)*rowHeight + 2*b.Padding
+
+	var box vg.Rectangle
+	if b.Left {
+		box.Min.X = c.Min.X
+	} else {
+		box.Min.X = c.Max.X - width
+	}
+	if b.Top {
+		box.Min.Y = c.Max.Y - height
+	} else {
+		box.Min.Y = c.Min.Y
+	}
+	box.Min = box.Min.Add(vg.Point{X: b.XOffs, Y: b.YOffs})
+	box.Max = box.Min.Add(vg.Point{X: width, Y: height})
+
+	outline := []vg.Point{
+		box.Min,
+		{X: box.Max.X, Y: box.Min.Y},
+		box.Max,
+		{X: box.Min.X, Y: box.Max.Y},
+	}
+	if b.FillColor != nil {
+		c.FillPolygon(b.FillColor, outline)
+	}
+	if b.LineStyle.Width != 0 {
+		c.StrokeLines(b.LineStyle, append(outline, box.Min))
+	}
+
+	sty := b.TextStyle
+	sty.YAlign = draw.YBottom
+	y := box.Max.Y - b.Padding - rowHeight
+	if b.Title != "" {
+		sty.XAlign = draw.XCenter
+		c.FillText(sty, vg.Point{X: (box.Min.X + box.Max.X) / 2, Y: y}, b.Title)
+		y -= rowHeight
+	}
+	left := box.Min.X + b.Padding
+	right := box.Max.X - b.Padding
+	for i, r := range b.Rows {
+		if b.fit != nil && i == b.fitRow {
+			thumb := draw.Canvas{
+				Canvas: c.Canvas,
+				Rectangle: vg.Rectangle{
+					Min: vg.Point{X: left, Y: y},
+					Max: vg.Point{X: left + thumbWidth*0.8, Y: y + rowHeight},
+				},
+			}
+			b.fit.Thumbnail(&thumb)
+		}
+		sty.XAlign = draw.XLeft
+		c.FillText(sty, vg.Point{X: left + thumbWidth, Y: y}, r.Name)
+		sty.XAlign = draw.XRight
+		c.FillText(sty, vg.Point{X: right, Y: y}, values[i])
+		y -= rowHeight
+	}
+}
+
+// maxLength returns the greater of a and b.
+func maxLength(a, b vg.Length) vg.Length {
+	if a > b {
+		return a
+	}
+	return b
+}
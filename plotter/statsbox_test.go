@@ -0,0 +1,160 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+// This example shows a histogram with its statistics and
+// the parameters of a fitted function in a statistics box.
+func ExampleStatsBox() {
+	rnd := rand.New(rand.NewSource(1))
+	vals := make(Values, 1000)
+	for i := range vals {
+		vals[i] = 2 + 0.5*rnd.NormFloat64()
+	}
+	edges := make([]float64, 21)
+	for i := range edges {
+		edges[i] = float64(i) * 0.2
+	}
+	h, err := NewHistogramEdges(unitYs{vals}, edges)
+	if err != nil {
+		log.Panic(err)
+	}
+
+	// A Gaussian fitted elsewhere to the histogram.
+	const amp, mu, sigma = 162.4, 2.01, 0.49
+	fit := NewFunction(func(x float64) float64 {
+		return amp * math.Exp(-(x-mu)*(x-mu)/(2*sigma*sigma))
+	})
+	fit.Color = color.RGBA{R: 255, A: 255}
+	fit.Width = vg.Points(1.5)
+
+	box, err := NewStatsBox(h, DefaultStatFields)
+	if err != nil {
+		log.Panic(err)
+	}
+	box.Title = "signal"
+	box.AddFit(fit,
+		FitParameter{Name: "Constant", Value: amp, Error: 6.4},
+		FitParameter{Name: "Mean", Value: mu, Error: 0.016},
+		FitParameter{Name: "Sigma", Value: sigma, Error: 0.011},
+	)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Histogram statistics"
+	p.Add(h, fit, box)
+	err = p.Save(300, 250, "testdata/statsBox.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestStatsBox(t *testing.T) {
+	cmpimg.CheckPlot(ExampleStatsBox, t, "statsBox.png")
+}
+
+func TestStatsBoxRows(t *testing.T) {
+	h, err := NewHistogramEdges(unitYs{Values{-1, 1, 2, 3, 10}}, []float64{0, 2, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := NewStatsBox(h, StatEntries|StatMean|StatOverflow)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b.AddFit(NewFunction(math.Sin), FitParameter{Name: "p0", Value: 1.5, Error: 0.25})
+	want := []StatRow{
+		{Name: "Entries", Value: 5, Format: "%.0f"},
+		{Name: "Mean", Value: 2},
+		{Name: "Overflow", Value: 1},
+		{Name: "p0", Value: 1.5, Error: 0.25},
+	}
+	if len(b.Rows) != len(want) {
+		t.Fatalf("unexpected rows: got:%v want:%v", b.Rows, want)
+	}
+	for i := range want {
+		if b.Rows[i] != want[i] {
+			t.Errorf("unexpected row %d: got:%v want:%v", i, b.Rows[i], want[i])
+		}
+	}
+	if b.fitRow != 3 {
+		t.Errorf("unexpected fit row: got:%d want:3", b.fitRow)
+	}
+
+	// The values are formatted when the box is
+	// drawn, with the Format set after construction.
+	b.Format = "%.2f"
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var rec recorder.Canvas
+	b.Plot(draw.NewCanvas(&rec, 200, 200), p)
+	var text []string
+	for _, a := range rec.Actions {
+		if a, ok := a.(*recorder.FillString); ok {
+			text = append(text, a.String)
+		}
+	}
+	wantText := []string{"Entries", "5", "Mean", "2.00", "Overflow", "1.00", "p0", "1.50 ± 0.25"}
+	if len(text) != len(wantText) {
+		t.Fatalf("unexpected text: got:%q want:%q", text, wantText)
+	}
+	for i := range wantText {
+		if text[i] != wantText[i] {
+			t.Errorf("unexpected text %d: got:%q want:%q", i, text[i], wantText[i])
+		}
+	}
+}
+
+func TestStatsBoxEntries(t *testing.T) {
+	// The values include one at each end of the
+	// range, where the bins of NewHistogram end,
+	// and one with zero weight.
+	data := XYs{{X: 0, Y: 1}, {X: 0.1, Y: 2}, {X: 0.7, Y: 0}, {X: 0.3, Y: 1}, {X: 1.1, Y: 3}}
+	h1, err := NewHistogram(data, 11)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	edges := make([]float64, 12)
+	for i := range edges {
+		edges[i] = float64(i) * 0.1
+	}
+	h2, err := NewHistogramEdges(data, edges)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s1, s2 := h1.Stats(), h2.Stats()
+	if s1.Entries != len(data) || s2.Entries != len(data) {
+		t.Errorf("unexpected entries: got:%d and %d want:%d", s1.Entries, s2.Entries, len(data))
+	}
+	if math.Abs(s1.Integral-s2.Integral) > 1e-12 || math.Abs(s1.Mean-s2.Mean) > 1e-12 || math.Abs(s1.RMS-s2.RMS) > 1e-12 {
+		t.Errorf("statistics differ: got:%+v and %+v", s1, s2)
+	}
+	for _, h := range []*Histogram{h1, h2} {
+		b, err := NewStatsBox(h, StatEntries)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := b.text(b.Rows[0]); got != "5" {
+			t.Errorf("unexpected entries row: got:%q want:\"5\"", got)
+		}
+	}
+}
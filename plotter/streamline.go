@@ -0,0 +1,445 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Streamlines implements the Plotter interface, drawing
+// evenly spaced streamlines of a vector field sampled on a
+// rectangular grid.
+//
+// The streamlines are computed when the Streamlines value is
+// created, using the algorithm of Jobard and Lefer, "Creating
+// Evenly-Spaced Streamlines of Arbitrary Density", 1997. The
+// placement of the streamlines is deterministic.
+type Streamlines struct {
+	// GridXYUV is the vector field the streamlines
+	// were computed for.
+	GridXYUV GridXYUV
+
+	// Lines holds the streamlines. Each streamline
+	// is a sequence of points in the direction of the
+	// field, with the speed of the field at each point
+	// held in Z.
+	Lines []XYZs
+
+	// LineStyle is the style of the streamlines.
+	draw.LineStyle
+
+	// Palette, if not nil, is used to color the
+	// streamlines according to the speed of the field,
+	// scaled uniformly between Min and Max.
+	Palette palette.Palette
+
+	// MaxWidth, if greater than the width of LineStyle,
+	// specifies that the width of the streamlines varies
+	// linearly with the speed of the field, from the width
+	// of LineStyle at Min to MaxWidth at Max.
+	MaxWidth vg.Length
+
+	// Min and Max define the range of speeds used
+	// to color and size the streamlines.
+	Min, Max float64
+
+	// HeadLength is the length of the arrow heads
+	// drawn at the middle of each streamline to show
+	// the direction of flow. If HeadLength is zero no
+	// arrow heads are drawn.
+	HeadLength vg.Length
+}
+
+// NewStreamlines returns Streamlines for the vector field g,
+// separated by sep, given as a fraction of the extent of the
+// grid. The grid must have at least two rows and two columns
+// with coordinates increasing with the column and row indices.
+// Min and Max are set to the range of speeds along the
+// streamlines.
+func NewStreamlines(g GridXYUV, sep float64) (*Streamlines, error) {
+	c, r := g.Dims()
+	if c < 2 || r < 2 {
+		return nil, errors.New("plotter: streamline grid smaller than 2×2")
+	}
+	for i := 0; i < c-1; i++ {
+		if !(g.X(i) < g.X(i+1)) {
+			return nil, errors.New("plotter: streamline grid X coordinates not increasing")
+		}
+	}
+	for i := 0; i < r-1; i++ {
+		if !(g.Y(i) < g.Y(i+1)) {
+			return nil, errors.New("plotter: streamline grid Y coordinates not increasing")
+		}
+	}
+	if !(sep > 0 && sep < 1) {
+		return nil, errors.New("plotter: streamline separation not in (0, 1)")
+	}
+	f := newStreamField(g)
+	lines := f.streamlines(sep)
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, l := range lines {
+		for _, p := range l {
+			min = math.Min(min, p.Z)
+			max = math.Max(max, p.Z)
+		}
+	}
+	return &Streamlines{
+		GridXYUV:   g,
+		Lines:      lines,
+		LineStyle:  DefaultLineStyle,
+		Min:        min,
+		Max:        max,
+		HeadLength: DefaultArrowHead,
+	}, nil
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (s *Streamlines) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	varying := s.Palette != nil || s.MaxWidth > s.LineStyle.Width
+	for _, l := range s.Lines {
+		pts := make([]vg.Point, len(l))
+		for i, p := range l {
+			pts[i] = vg.Point{X: trX(p.X), Y: trY(p.Y)}
+		}
+		if !varying {
+			c.StrokeLines(s.LineStyle, c.ClipLinesXY(pts)...)
+		} else {
+			for i := 1; i < len(pts); i++ {
+				sty := s.style((l[i-1].Z + l[i].Z) / 2)
+				c.StrokeLines(sty, c.ClipLinesXY(pts[i-1:i+1])...)
+			}
+		}
+
+		if s.HeadLength == 0 || len(pts) < 2 {
+			continue
+		}
+		mid := len(pts) / 2
+		tip := pts[mid]
+		d := tip.Sub(pts[mid-1])
+		n := vg.Length(math.Hypot(float64(d.X), float64(d.Y)))
+		if n == 0 || !c.Contains(tip) {
+			continue
+		}
+		sty := s.style(l[mid].Z)
+		drawArrowHead(&c, sty.Color, tip, d.Scale(1/n), s.HeadLength)
+	}
+}
+
+// style returns the line style for the given speed.
+func (s *Streamlines) style(speed float64) draw.LineStyle {
+	sty := s.LineStyle
+	if s.Palette != nil {
+		sty.Color = paletteColor(s.Palette, s.Min, s.Max, speed)
+	}
+	if s.MaxWidth > sty.Width && s.Max > s.Min {
+		t := math.Max(0, math.Min(1, (speed-s.Min)/(s.Max-s.Min)))
+		sty.Width += vg.Length(t) * (s.MaxWidth - sty.Width)
+	}
+	return sty
+}
+
+// DataRange implements the DataRange method
+// of the plot.DataRanger interface.
+func (s *Streamlines) DataRange() (xmin, xmax, ymin, ymax float64) {
+	c, r := s.GridXYUV.Dims()
+	return s.GridXYUV.X(0), s.GridXYUV.X(c - 1), s.GridXYUV.Y(0), s.GridXYUV.Y(r - 1)
+}
+
+// Thumbnail draws a line with an arrow head,
+// implementing the plot.Thumbnailer interface.
+func (s *Streamlines) Thumbnail(c *draw.Canvas) {
+	sty := s.style((s.Min + s.Max) / 2)
+	y := c.Center().Y
+	drawArrow(c, sty, vg.Point{X: c.Min.X, Y: y}, vg.Point{X: c.Max.X, Y: y}, s.HeadLength)
+}
+
+// streamField provides bilinear interpolation of a
+// GridXYUV in coordinates normalized to the unit square.
+type streamField struct {
+	g      GridXYUV
+	xs, ys []float64
+}
+
+func newStreamField(g GridXYUV) *streamField {
+	c, r := g.Dims()
+	f := &streamField{g: g, xs: make([]float64, c), ys: make([]float64, r)}
+	for i := range f.xs {
+		f.xs[i] = g.X(i)
+	}
+	for j := range f.ys {
+		f.ys[j] = g.Y(j)
+	}
+	return f
+}
+
+// data returns the data coordinates of the normalized
+// point (x, y).
+func (f *streamField) data(x, y float64) (float64, float64) {
+	x0, x1 := f.xs[0], f.xs[len(f.xs)-1]
+	y0, y1 := f.ys[0], f.ys[len(f.ys)-1]
+	return x0 + x*(x1-x0), y0 + y*(y1-y0)
+}
+
+// at returns the field at the normalized point (x, y) as a
+// unit direction in normalized coordinates and the speed
+// in data units. The returned ok is false if the point is
+// outside the grid or the field is zero or not finite.
+func (f *streamField) at(x, y float64) (dx, dy, speed float64, ok bool) {
+	const eps = 1e-9 // allowance for rounding at the grid edges
+	if x < -eps || x > 1+eps || y < -eps || y > 1+eps {
+		return 0, 0, 0, false
+	}
+	x = math.Max(0, math.Min(1, x))
+	y = math.Max(0, math.Min(1, y))
+	px, py := f.data(x, y)
+	c, tx := gridCell(f.xs, px)
+	r, ty := gridCell(f.ys, py)
+	u00, v00 := f.g.UV(c, r)
+	u10, v10 := f.g.UV(c+1, r)
+	u01, v01 := f.g.UV(c, r+1)
+	u11, v11 := f.g.UV(c+1, r+1)
+	u := (1-tx)*(1-ty)*u00 + tx*(1-ty)*u10 + (1-tx)*ty*u01 + tx*ty*u11
+	v := (1-tx)*(1-ty)*v00 + tx*(1-ty)*v10 + (1-tx)*ty*v01 + tx*ty*v11
+
+	speed = math.Hypot(u, v)
+	dx = u / (f.xs[len(f.xs)-1] - f.xs[0])
+	dy = v / (f.ys[len(f.ys)-1] - f.ys[0])
+	n := math.Hypot(dx, dy)
+	if n == 0 || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, 0, 0, false
+	}
+	return dx / n, dy / n, speed, true
+}
+
+// gridCell returns the index of the cell of the increasing
+// coordinates cs containing v, and the fractional position
+// of v within that cell.
+func gridCell(cs []float64, v float64) (int, float64) {
+	i := sort.SearchFloat64s(cs, v) - 1
+	switch {
+	case i < 0:
+		i = 0
+	case i > len(cs)-2:
+		i = len(cs) - 2
+	}
+	return i, (v - cs[i]) / (cs[i+1] - cs[i])
+}
+
+// streamPoint is a point of a streamline in
+// normalized coordinates.
+type streamPoint struct {
+	x, y  float64
+	line  int
+	index int
+}
+
+// occupancy is a uniform grid of buckets holding the
+// points of the streamlines placed so far.
+type occupancy struct {
+	n       int
+	size    float64
+	buckets [][]streamPoint
+}
+
+func newOccupancy(size float64) *occupancy {
+	n := int(math.Ceil(1/size)) + 1
+	return &occupancy{n: n, size: size, buckets: make([][]streamPoint, n*n)}
+}
+
+func (o *occupancy) bucket(x, y float64) (int, int) {
+	i := int(x / o.size)
+	j := int(y / o.size)
+	if i < 0 {
+		i = 0
+	} else if i >= o.n {
+		i = o.n - 1
+	}
+	if j < 0 {
+		j = 0
+	} else if j >= o.n {
+		j = o.n - 1
+	}
+	return i, j
+}
+
+func (o *occupancy) add(p streamPoint) {
+	i, j := o.bucket(p.x, p.y)
+	o.buckets[i*o.n+j] = append(o.buckets[i*o.n+j], p)
+}
+
+// remove removes all points of the given line.
+func (o *occupancy) remove(line int) {
+	for k, b := range o.buckets {
+		kept := b[:0]
+		for _, p := range b {
+			if p.line != line {
+				kept = append(kept, p)
+			}
+		}
+		o.buckets[k] = kept
+	}
+}
+
+// free returns whether no point is within d of (x, y),
+// ignoring points of the given line whose index is within
+// skip of index.
+func (o *occupancy) free(x, y, d float64, line, index, skip int) bool {
+	ci, cj := o.bucket(x, y)
+	for i := ci - 1; i <= ci+1; i++ {
+		for j := cj - 1; j <= cj+1; j++ {
+			if i < 0 || j < 0 || i >= o.n || j >= o.n {
+				continue
+			}
+			for _, p := range o.buckets[i*o.n+j] {
+				if p.line == line && absInt(p.index-index) <= skip {
+					continue
+				}
+				if math.Hypot(p.x-x, p.y-y) < d {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+func absInt(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// streamlines returns evenly spaced streamlines
+// separated by sep in normalized coordinates.
+func (f *streamField) streamlines(sep float64) []XYZs {
+	const (
+		stepsPerSep = 5    // integration steps per separation
+		testRatio   = 0.5  // ratio of the stopping distance to sep
+		seedRatio   = 0.99 // ratio of the seeding distance to sep
+		minPoints   = 3    // minimum number of points in a streamline
+	)
+	step := sep / stepsPerSep
+	dtest := testRatio * sep
+	skip := int(math.Ceil(2 * sep / step))
+	maxSteps := int(4 / step)
+
+	occ := newOccupancy(sep)
+	var lines [][]streamPoint
+
+	// trace integrates a streamline from the seed in both
+	// directions, returning nil if it is too short.
+	trace := func(x, y float64) []streamPoint {
+		id := len(lines)
+		seed := streamPoint{x: x, y: y, line: id}
+		occ.add(seed)
+		var fwd, bwd []streamPoint
+		for _, dir := range []float64{1, -1} {
+			p := seed
+			for k := 1; k <= maxSteps; k++ {
+				nx, ny, ok := f.rk2(p.x, p.y, dir*step)
+				if !ok {
+					break
+				}
+				p = streamPoint{x: nx, y: ny, line: id, index: int(dir) * k}
+				if !occ.free(nx, ny, dtest, id, p.index, skip) {
+					break
+				}
+				occ.add(p)
+				if dir > 0 {
+					fwd = append(fwd, p)
+				} else {
+					bwd = append(bwd, p)
+				}
+			}
+		}
+		if len(fwd)+len(bwd)+1 < minPoints {
+			occ.remove(id)
+			return nil
+		}
+		line := make([]streamPoint, 0, len(fwd)+len(bwd)+1)
+		for i := len(bwd) - 1; i >= 0; i-- {
+			line = append(line, bwd[i])
+		}
+		line = append(line, seed)
+		return append(line, fwd...)
+	}
+
+	// seed starts a new streamline at (x, y) if the
+	// point is far enough from all other streamlines.
+	seed := func(x, y float64) {
+		if _, _, _, ok := f.at(x, y); !ok {
+			return
+		}
+		if !occ.free(x, y, seedRatio*sep, -1, 0, 0) {
+			return
+		}
+		if l := trace(x, y); l != nil {
+			lines = append(lines, l)
+		}
+	}
+
+	// Seed streamlines from the existing ones, then
+	// fill any regions that have not been reached from
+	// a regular lattice of seeds.
+	grow := func(from int) {
+		for i := from; i < len(lines); i++ {
+			for _, p := range lines[i] {
+				dx, dy, _, ok := f.at(p.x, p.y)
+				if !ok {
+					continue
+				}
+				seed(p.x-dy*sep, p.y+dx*sep)
+				seed(p.x+dy*sep, p.y-dx*sep)
+			}
+		}
+	}
+	seed(0.5, 0.5)
+	grow(0)
+	for x := sep / 2; x < 1; x += sep {
+		for y := sep / 2; y < 1; y += sep {
+			n := len(lines)
+			seed(x, y)
+			grow(n)
+		}
+	}
+
+	xyzs := make([]XYZs, len(lines))
+	for i, l := range lines {
+		xyzs[i] = make(XYZs, len(l))
+		for j, p := range l {
+			xyzs[i][j].X, xyzs[i][j].Y = f.data(p.x, p.y)
+			_, _, xyzs[i][j].Z, _ = f.at(p.x, p.y)
+		}
+	}
+	return xyzs
+}
+
+// rk2 takes a midpoint integration step of length h along
+// the direction field from the normalized point (x, y).
+func (f *streamField) rk2(x, y, h float64) (float64, float64, bool) {
+	dx, dy, _, ok := f.at(x, y)
+	if !ok {
+		return 0, 0, false
+	}
+	mx, my, _, ok := f.at(x+dx*h/2, y+dy*h/2)
+	if !ok {
+		return 0, 0, false
+	}
+	nx, ny := x+mx*h, y+my*h
+	if _, _, _, ok := f.at(nx, ny); !ok {
+		return 0, 0, false
+	}
+	return nx, ny, true
+}
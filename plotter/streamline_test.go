@@ -0,0 +1,114 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+)
+
+func ExampleStreamlines() {
+	field := vortexField{n: 21, min: -1, delta: 0.1}
+
+	s, err := NewStreamlines(field, 0.06)
+	if err != nil {
+		log.Panic(err)
+	}
+	s.Palette = palette.Heat(16, 1)
+	s.MaxWidth = vg.Points(2)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Vortex streamlines"
+	p.Add(s)
+	err = p.Save(250, 250, "testdata/streamlines.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestStreamlines(t *testing.T) {
+	cmpimg.CheckPlot(ExampleStreamlines, t, "streamlines.png")
+}
+
+// uniformField is a GridXYUV holding a
+// uniform field in the X direction.
+type uniformField struct{ n int }
+
+func (f uniformField) Dims() (c, r int)           { return f.n, f.n }
+func (f uniformField) X(c int) float64            { return float64(c) }
+func (f uniformField) Y(r int) float64            { return float64(r) }
+func (f uniformField) UV(c, r int) (u, v float64) { return 2, 0 }
+
+func TestStreamlinesUniform(t *testing.T) {
+	s, err := NewStreamlines(uniformField{n: 11}, 0.1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if math.Abs(s.Min-2) > 1e-12 || math.Abs(s.Max-2) > 1e-12 {
+		t.Errorf("unexpected speed range: got:[%v, %v] want:[2, 2]", s.Min, s.Max)
+	}
+	// Streamlines of a uniform field are horizontal lines
+	// spanning the grid, separated by the requested spacing.
+	ys := make(map[float64]bool)
+	for _, l := range s.Lines {
+		y := l[0].Y
+		for _, p := range l {
+			if math.Abs(p.Y-y) > 1e-9 {
+				t.Fatalf("streamline of uniform field is not horizontal: %v", l)
+			}
+		}
+		if l[0].X > l[len(l)-1].X {
+			t.Errorf("streamline does not follow the field direction")
+		}
+		ys[math.Floor(y*1e6+0.5)/1e6] = true
+	}
+	if len(ys) != len(s.Lines) {
+		t.Errorf("streamlines overlap")
+	}
+	if len(s.Lines) < 10 || len(s.Lines) > 12 {
+		t.Errorf("unexpected number of streamlines: got:%d want:11±1", len(s.Lines))
+	}
+}
+
+func TestStreamlinesDeterministic(t *testing.T) {
+	field := vortexField{n: 21, min: -1, delta: 0.1}
+	s1, err := NewStreamlines(field, 0.05)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s2, err := NewStreamlines(field, 0.05)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(s1.Lines, s2.Lines) {
+		t.Error("streamline placement is not deterministic")
+	}
+	if len(s1.Lines) == 0 {
+		t.Error("no streamlines")
+	}
+}
+
+// reversedField is a uniformField with the
+// Y coordinates decreasing with the row index.
+type reversedField struct{ uniformField }
+
+func (f reversedField) Y(r int) float64 { return float64(-r) }
+
+func TestStreamlinesGridOrder(t *testing.T) {
+	_, err := NewStreamlines(reversedField{uniformField{n: 5}}, 0.1)
+	if err == nil {
+		t.Errorf("expected error for decreasing Y coordinates")
+	}
+}
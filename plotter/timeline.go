@@ -0,0 +1,329 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// Task is a task of a Timeline, drawn as a bar
+// from its start to its end time.
+type Task struct {
+	// Row is the index of the row
+	// the task is drawn in.
+	Row int
+
+	// Start and End are the times of the start
+	// and end of the task, in the units of the
+	// X axis, such as seconds since the Unix epoch
+	// for the default plot.TimeTicks.
+	Start, End float64
+
+	// Label is the text drawn on the bar of the
+	// task, or after it if the bar is too short.
+	Label string
+
+	// Color is the fill color of the bar. If Color
+	// is nil, the Timeline Color is used.
+	Color color.Color
+
+	// DependsOn holds the indices of the tasks of
+	// the Timeline that the task depends on.
+	DependsOn []int
+}
+
+// Milestone is a point in time marked
+// by a glyph in a row of a Timeline.
+type Milestone struct {
+	// Row is the index of the row the
+	// milestone is drawn in.
+	Row int
+
+	// Time is the time of the milestone.
+	Time float64
+
+	// Label is the text drawn after the glyph.
+	Label string
+}
+
+// Timeline implements the Plotter interface, drawing tasks
+// as horizontal bars from their start to their end times,
+// in rows of related tasks.
+//
+// Row i is drawn at the Y value i, so the rows can be
+// named with the NominalY method of the plot. The times
+// are X values, so plot.TimeTicks can be used to mark
+// them on the X axis.
+type Timeline struct {
+	// Rows holds the names of the rows.
+	Rows []string
+
+	// Tasks and Milestones hold the tasks
+	// and milestones of the timeline.
+	Tasks      []Task
+	Milestones []Milestone
+
+	// Width is the width of the bars.
+	Width vg.Length
+
+	// Color is the fill color of bars
+	// of tasks without a color.
+	Color color.Color
+
+	// LineStyle is the style of the outline of the bars.
+	draw.LineStyle
+
+	// LabelStyle is the style of the task
+	// and milestone labels.
+	LabelStyle draw.TextStyle
+
+	// MilestoneStyle is the style of
+	// the milestone glyphs.
+	MilestoneStyle draw.GlyphStyle
+
+	// ArrowStyle is the style of the arrows drawn from
+	// the end of each task to the start of the tasks
+	// that depend on it. If the width is zero, the
+	// dependencies are not drawn.
+	ArrowStyle draw.LineStyle
+
+	// ArrowSize is the length of the arrow heads.
+	ArrowSize vg.Length
+}
+
+// NewTimeline returns a Timeline of the given tasks in rows
+// with the given names. The bars are drawn with the given
+// width, and the dependency arrows are not drawn. An error is
+// returned if a task refers to an unknown row or task, if a
+// time is NaN or infinite, or if a task ends before it starts.
+func NewTimeline(rows []string, tasks []Task, width vg.Length) (*Timeline, error) {
+	if len(rows) == 0 {
+		return nil, ErrNoData
+	}
+	if width <= 0 {
+		return nil, errors.New("plotter: width parameter was not positive")
+	}
+	for i, t := range tasks {
+		if t.Row < 0 || t.Row >= len(rows) {
+			return nil, fmt.Errorf("plotter: task %d has unknown row %d", i, t.Row)
+		}
+		if err := CheckFloats(t.Start, t.End); err != nil {
+			return nil, err
+		}
+		if t.End < t.Start {
+			return nil, fmt.Errorf("plotter: task %d ends before it starts", i)
+		}
+		for _, d := range t.DependsOn {
+			if d < 0 || d >= len(tasks) || d == i {
+				return nil, fmt.Errorf("plotter: task %d depends on invalid task %d", i, d)
+			}
+		}
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	return &Timeline{
+		Rows:      rows,
+		Tasks:     tasks,
+		Width:     width,
+		Color:     color.Gray{Y: 192},
+		LineStyle: DefaultLineStyle,
+		LabelStyle: draw.TextStyle{
+			Color:  color.Black,
+			Font:   fnt,
+			YAlign: draw.YCenter,
+		},
+		MilestoneStyle: draw.GlyphStyle{
+			Color:  color.Black,
+			Radius: width / 3,
+			Shape:  draw.DiamondGlyph{},
+		},
+		ArrowStyle: draw.LineStyle{Color: color.Black},
+		ArrowSize:  vg.Points(4),
+	}, nil
+}
+
+// AddMilestone adds a milestone to the timeline. An error is
+// returned if the row is unknown or the time is not finite.
+func (t *Timeline) AddMilestone(m Milestone) error {
+	if m.Row < 0 || m.Row >= len(t.Rows) {
+		return fmt.Errorf("plotter: milestone has unknown row %d", m.Row)
+	}
+	if err := CheckFloats(m.Time); err != nil {
+		return err
+	}
+	t.Milestones = append(t.Milestones, m)
+	return nil
+}
+
+// timelineLabelPad is the space between the labels
+// of a Timeline and the bar or glyph they follow.
+const timelineLabelPad = vg.Length(2)
+
+// Plot implements the plot.Plotter interface.
+func (t *Timeline) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+
+	for _, task := range t.Tasks {
+		y := trY(float64(task.Row))
+		xmin, xmax := trX(task.Start), trX(task.End)
+		pts := []vg.Point{
+			{X: xmin, Y: y - t.Width/2},
+			{X: xmin, Y: y + t.Width/2},
+			{X: xmax, Y: y + t.Width/2},
+			{X: xmax, Y: y - t.Width/2},
+		}
+		col := task.Color
+		if col == nil {
+			col = t.Color
+		}
+		c.FillPolygon(col, c.ClipPolygonXY(pts))
+		c.StrokeLines(t.LineStyle, c.ClipLinesXY(append(pts, pts[0]))...)
+	}
+
+	if t.ArrowStyle.Width != 0 {
+		for _, task := range t.Tasks {
+			for _, d := range task.DependsOn {
+				t.drawArrow(c, t.Tasks[d], task, trX, trY)
+			}
+		}
+	}
+
+	for _, m := range t.Milestones {
+		pt := vg.Point{X: trX(m.Time), Y: trY(float64(m.Row))}
+		if c.Contains(pt) {
+			c.DrawGlyph(t.MilestoneStyle, pt)
+		}
+	}
+
+	for _, task := range t.Tasks {
+		if task.Label == "" {
+			continue
+		}
+		y := trY(float64(task.Row))
+		xmin, xmax := trX(task.Start), trX(task.End)
+		if !c.Contains(vg.Point{X: xmax, Y: y}) {
+			continue
+		}
+		sty := t.LabelStyle
+		pt := vg.Point{X: xmax + timelineLabelPad, Y: y}
+		if sty.Width(task.Label)+2*timelineLabelPad <= xmax-xmin {
+			sty.XAlign = draw.XCenter
+			pt.X = (xmin + xmax) / 2
+		}
+		c.FillText(sty, pt, task.Label)
+	}
+	for _, m := range t.Milestones {
+		if m.Label == "" {
+			continue
+		}
+		pt := vg.Point{X: trX(m.Time), Y: trY(float64(m.Row))}
+		if !c.Contains(pt) {
+			continue
+		}
+		pt.X += t.MilestoneStyle.Radius + timelineLabelPad
+		c.FillText(t.LabelStyle, pt, m.Label)
+	}
+}
+
+// drawArrow draws an arrow from the end of the task
+// from to the start of the task to. The arrow leaves
+// from horizontally, turns to the row of to, and
+// enters to horizontally.
+func (t *Timeline) drawArrow(c draw.Canvas, from, to Task, trX, trY func(float64) vg.Length) {
+	start := vg.Point{X: trX(from.End), Y: trY(float64(from.Row))}
+	end := vg.Point{X: trX(to.Start), Y: trY(float64(to.Row))}
+	line := []vg.Point{start}
+	if from.Row != to.Row {
+		x := start.X + t.ArrowSize
+		if x > end.X-t.ArrowSize {
+			x = end.X - t.ArrowSize
+		}
+		line = append(line,
+			vg.Point{X: x, Y: start.Y},
+			vg.Point{X: x, Y: end.Y},
+		)
+	}
+	line = append(line, end)
+	c.StrokeLines(t.ArrowStyle, c.ClipLinesXY(line)...)
+
+	// The head points along the last segment.
+	dir := end.Sub(line[len(line)-2])
+	l := vg.Length(math.Hypot(float64(dir.X), float64(dir.Y)))
+	if l == 0 {
+		return
+	}
+	dir = dir.Scale(t.ArrowSize / l)
+	norm := vg.Point{X: -dir.Y / 2, Y: dir.X / 2}
+	base := end.Sub(dir)
+	head := []vg.Point{end, base.Add(norm), base.Sub(norm)}
+	c.FillPolygon(t.ArrowStyle.Color, c.ClipPolygonXY(head))
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (t *Timeline) DataRange() (xmin, xmax, ymin, ymax float64) {
+	xmin, xmax = math.Inf(1), math.Inf(-1)
+	for _, task := range t.Tasks {
+		xmin = math.Min(xmin, task.Start)
+		xmax = math.Max(xmax, task.End)
+	}
+	for _, m := range t.Milestones {
+		xmin = math.Min(xmin, m.Time)
+		xmax = math.Max(xmax, m.Time)
+	}
+	return xmin, xmax, 0, float64(len(t.Rows) - 1)
+}
+
+// GlyphBoxes implements the plot.GlyphBoxer interface,
+// making room for the width of the bars and the labels
+// that follow them.
+func (t *Timeline) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	var boxes []plot.GlyphBox
+	for i := range t.Rows {
+		boxes = append(boxes, plot.GlyphBox{
+			Y: plt.Y.Norm(float64(i)),
+			Rectangle: vg.Rectangle{
+				Min: vg.Point{Y: -t.Width / 2},
+				Max: vg.Point{Y: t.Width / 2},
+			},
+		})
+	}
+	label := func(x float64, row int, off vg.Length, txt string) {
+		w := t.LabelStyle.Width(txt)
+		h := t.LabelStyle.Height(txt)
+		boxes = append(boxes, plot.GlyphBox{
+			X: plt.X.Norm(x),
+			Y: plt.Y.Norm(float64(row)),
+			Rectangle: vg.Rectangle{
+				Min: vg.Point{X: off, Y: -h / 2},
+				Max: vg.Point{X: off + w, Y: h / 2},
+			},
+		})
+	}
+	for _, task := range t.Tasks {
+		if task.Label != "" {
+			label(task.End, task.Row, timelineLabelPad, task.Label)
+		}
+	}
+	for _, m := range t.Milestones {
+		if m.Label != "" {
+			label(m.Time, m.Row, t.MilestoneStyle.Radius+timelineLabelPad, m.Label)
+		}
+	}
+	return boxes
+}
+
+// Thumbnail implements the plot.Thumbnailer interface.
+func (t *Timeline) Thumbnail(c *draw.Canvas) {
+	(&BarChart{Color: t.Color, LineStyle: t.LineStyle}).Thumbnail(c)
+}
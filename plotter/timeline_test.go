@@ -0,0 +1,95 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"log"
+	"math"
+	"testing"
+	"time"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleTimeline draws the schedule of the jobs of a
+// build pipeline, with the dependencies between them.
+func ExampleTimeline() {
+	t0 := time.Date(2017, time.March, 1, 9, 0, 0, 0, time.UTC)
+	at := func(min int) float64 {
+		return float64(t0.Add(time.Duration(min) * time.Minute).Unix())
+	}
+	test := color.RGBA{R: 140, G: 200, B: 140, A: 255}
+	tasks := []Task{
+		{Row: 0, Start: at(0), End: at(12), Label: "compile"},
+		{Row: 1, Start: at(13), End: at(30), Label: "unit", Color: test, DependsOn: []int{0}},
+		{Row: 1, Start: at(31), End: at(52), Label: "integration", Color: test, DependsOn: []int{1}},
+		{Row: 2, Start: at(13), End: at(20), Label: "lint", Color: test, DependsOn: []int{0}},
+		{Row: 3, Start: at(53), End: at(60), Label: "deploy", DependsOn: []int{2, 3}},
+	}
+
+	tl, err := NewTimeline([]string{"build", "test", "check", "release"}, tasks, vg.Points(12))
+	if err != nil {
+		log.Panic(err)
+	}
+	tl.ArrowStyle.Width = vg.Points(0.5)
+	err = tl.AddMilestone(Milestone{Row: 3, Time: at(70), Label: "v1.0"})
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Pipeline"
+	p.Add(tl)
+	p.NominalY(tl.Rows...)
+	p.X.Tick.Marker = plot.TimeTicks{Format: "15:04"}
+
+	err = p.Save(300, 150, "testdata/timeline.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	cmpimg.CheckPlot(ExampleTimeline, t, "timeline.png")
+}
+
+func TestNewTimeline(t *testing.T) {
+	rows := []string{"a", "b"}
+	for _, test := range []struct {
+		name  string
+		tasks []Task
+	}{
+		{name: "unknown row", tasks: []Task{{Row: 2, End: 1}}},
+		{name: "reversed", tasks: []Task{{Start: 2, End: 1}}},
+		{name: "NaN", tasks: []Task{{Start: math.NaN(), End: 1}}},
+		{name: "unknown dependency", tasks: []Task{{End: 1, DependsOn: []int{1}}}},
+		{name: "self dependency", tasks: []Task{{End: 1, DependsOn: []int{0}}}},
+	} {
+		if _, err := NewTimeline(rows, test.tasks, 1); err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+
+	tl, err := NewTimeline(rows, []Task{{Start: 2, End: 5}, {Row: 1, Start: 3, End: 4}}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := tl.AddMilestone(Milestone{Row: 2, Time: 1}); err == nil {
+		t.Error("expected error for milestone with unknown row")
+	}
+	if err := tl.AddMilestone(Milestone{Row: 1, Time: 7}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	xmin, xmax, ymin, ymax := tl.DataRange()
+	if xmin != 2 || xmax != 7 || ymin != 0 || ymax != 1 {
+		t.Errorf("unexpected data range: got:[%v, %v]×[%v, %v] want:[2, 7]×[0, 1]", xmin, xmax, ymin, ymax)
+	}
+}
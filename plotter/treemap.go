@@ -0,0 +1,388 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// TreeNode is a node of a weighted tree drawn by a Treemap.
+type TreeNode struct {
+	// Label is the text drawn in the cell of the node.
+	Label string
+
+	// Weight is the weight of a leaf node, giving
+	// the area of its cell. The weight of a node
+	// with children is the sum of their weights,
+	// and its Weight field is ignored.
+	Weight float64
+
+	// Value is the value used to color the cell
+	// of a leaf node when a Treemap is colored
+	// by value.
+	Value float64
+
+	// Children holds the children of the node.
+	Children []*TreeNode
+}
+
+// weight returns the total weight of the leaves of n.
+func (n *TreeNode) weight() float64 {
+	if len(n.Children) == 0 {
+		return n.Weight
+	}
+	var w float64
+	for _, c := range n.Children {
+		w += c.weight()
+	}
+	return w
+}
+
+// check returns an error if a leaf below n has an
+// invalid weight or value, and returns the depth of
+// the deepest leaf below n.
+func (n *TreeNode) check(depth int) (int, error) {
+	if len(n.Children) == 0 {
+		if err := CheckFloats(n.Weight, n.Value); err != nil {
+			return 0, err
+		}
+		if n.Weight < 0 {
+			return 0, fmt.Errorf("plotter: negative weight of tree node %q", n.Label)
+		}
+		return depth, nil
+	}
+	max := depth
+	for _, c := range n.Children {
+		if c == nil {
+			return 0, errors.New("plotter: nil tree node")
+		}
+		d, err := c.check(depth + 1)
+		if err != nil {
+			return 0, err
+		}
+		if d > max {
+			max = d
+		}
+	}
+	return max, nil
+}
+
+// Treemap implements the Plotter interface, drawing a
+// weighted tree as nested rectangular cells, each with an
+// area proportional to its weight. The cells of the children
+// of a node are placed in its cell with the squarified
+// layout, which keeps the cells close to square.
+//
+// The treemap fills the square from 0 to 1 on the X and
+// Y axes, so the axes of the plot are usually hidden.
+type Treemap struct {
+	// Root is the root of the drawn tree.
+	Root *TreeNode
+
+	// ColorMap is used to fill the cells. If ColorMap
+	// is nil, the cells are not filled.
+	ColorMap palette.ColorMap
+
+	// ColorByValue specifies that the leaf cells are
+	// colored by their Value and the cells of the other
+	// nodes are not filled. Otherwise all the cells are
+	// colored by their depth in the tree, starting from
+	// zero at the root. When coloring by value, the
+	// range of the ColorMap should be set to the range
+	// of the values.
+	ColorByValue bool
+
+	// Padding is the space between the edges
+	// of a cell and the cells of its children.
+	Padding vg.Length
+
+	// LineStyle is the style of the cell outlines.
+	draw.LineStyle
+
+	// LabelStyle is the style of the labels of the leaf
+	// cells, drawn in their top left corners. Labels are
+	// shortened to fit their cells, and are not drawn in
+	// cells too small to hold them.
+	LabelStyle draw.TextStyle
+}
+
+// NewTreemap returns a Treemap of the tree with the given root,
+// colored by depth using the given ColorMap, which may be nil.
+// An error is returned if a leaf has a negative or non-finite
+// weight, or if the total weight is zero.
+//
+// NewTreemap sets the range of the ColorMap, which is not
+// copied, to the range of the depths of the tree, replacing
+// any range set by the caller and changing the colors of
+// other plotters sharing the ColorMap. To color by another
+// range, set the range of the ColorMap after calling
+// NewTreemap.
+func NewTreemap(root *TreeNode, cm palette.ColorMap) (*Treemap, error) {
+	if root == nil {
+		return nil, ErrNoData
+	}
+	depth, err := root.check(0)
+	if err != nil {
+		return nil, err
+	}
+	if root.weight() == 0 {
+		return nil, errors.New("plotter: total weight of tree is zero")
+	}
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		return nil, err
+	}
+	if cm != nil {
+		cm.SetMin(0)
+		cm.SetMax(math.Max(float64(depth), 1))
+	}
+	return &Treemap{
+		Root:      root,
+		ColorMap:  cm,
+		Padding:   vg.Points(2),
+		LineStyle: DefaultLineStyle,
+		LabelStyle: draw.TextStyle{
+			Color:  color.Black,
+			Font:   fnt,
+			XAlign: draw.XLeft,
+			YAlign: draw.YTop,
+		},
+	}, nil
+}
+
+// treemapCell is the cell of a node of a Treemap.
+type treemapCell struct {
+	node  *TreeNode
+	depth int
+	vg.Rectangle
+}
+
+// layout returns the cells of the tree placed in r, with
+// each parent before its children.
+func (t *Treemap) layout(r vg.Rectangle) []treemapCell {
+	var cells []treemapCell
+	var place func(n *TreeNode, depth int, r vg.Rectangle)
+	place = func(n *TreeNode, depth int, r vg.Rectangle) {
+		cells = append(cells, treemapCell{node: n, depth: depth, Rectangle: r})
+		if len(n.Children) == 0 {
+			return
+		}
+		inner := vg.Rectangle{
+			Min: vg.Point{X: r.Min.X + t.Padding, Y: r.Min.Y + t.Padding},
+			Max: vg.Point{X: r.Max.X - t.Padding, Y: r.Max.Y - t.Padding},
+		}
+		if inner.Max.X <= inner.Min.X || inner.Max.Y <= inner.Min.Y {
+			return
+		}
+
+		// Lay out the children from the heaviest,
+		// skipping those without weight.
+		var children []*TreeNode
+		var weights []float64
+		for _, c := range n.Children {
+			if w := c.weight(); w > 0 {
+				children = append(children, c)
+				weights = append(weights, w)
+			}
+		}
+		order := make([]int, len(children))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool { return weights[order[i]] > weights[order[j]] })
+		sorted := make([]float64, len(order))
+		for i, k := range order {
+			sorted[i] = weights[k]
+		}
+		for i, cr := range squarify(sorted, inner) {
+			place(children[order[i]], depth+1, cr)
+		}
+	}
+	place(t.Root, 0, r)
+	return cells
+}
+
+// squarify returns the rectangles dividing r in proportion to the
+// given weights, which must be in decreasing order, using the
+// squarified treemap layout of Bruls, Huizing and van Wijk. Rows
+// of rectangles are placed along the shorter side of the space
+// remaining, adding rectangles to a row while that improves the
+// worst aspect ratio in the row.
+func squarify(weights []float64, r vg.Rectangle) []vg.Rectangle {
+	out := make([]vg.Rectangle, len(weights))
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	size := r.Size()
+	scale := float64(size.X) * float64(size.Y) / total
+	areas := make([]float64, len(weights))
+	for i, w := range weights {
+		areas[i] = w * scale
+	}
+
+	for i := 0; i < len(areas); {
+		size := r.Size()
+		short := math.Min(float64(size.X), float64(size.Y))
+		if short <= 0 {
+			for k := i; k < len(areas); k++ {
+				out[k] = vg.Rectangle{Min: r.Min, Max: r.Min}
+			}
+			break
+		}
+
+		j := i + 1
+		worst := worstAspect(areas[i:j], short)
+		for j < len(areas) {
+			next := worstAspect(areas[i:j+1], short)
+			if next > worst {
+				break
+			}
+			worst = next
+			j++
+		}
+
+		var sum float64
+		for _, a := range areas[i:j] {
+			sum += a
+		}
+		thick := vg.Length(sum / short)
+		if size.X >= size.Y {
+			// Place the row as a column on the left.
+			y := r.Max.Y
+			for k := i; k < j; k++ {
+				h := vg.Length(areas[k]) / thick
+				out[k] = vg.Rectangle{
+					Min: vg.Point{X: r.Min.X, Y: y - h},
+					Max: vg.Point{X: r.Min.X + thick, Y: y},
+				}
+				y -= h
+			}
+			r.Min.X += thick
+		} else {
+			// Place the row along the top.
+			x := r.Min.X
+			for k := i; k < j; k++ {
+				w := vg.Length(areas[k]) / thick
+				out[k] = vg.Rectangle{
+					Min: vg.Point{X: x, Y: r.Max.Y - thick},
+					Max: vg.Point{X: x + w, Y: r.Max.Y},
+				}
+				x += w
+			}
+			r.Max.Y -= thick
+		}
+		i = j
+	}
+	return out
+}
+
+// worstAspect returns the largest aspect ratio of the
+// rectangles with the given areas placed in a row along
+// a side of the given length.
+func worstAspect(areas []float64, side float64) float64 {
+	var sum float64
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, a := range areas {
+		sum += a
+		min = math.Min(min, a)
+		max = math.Max(max, a)
+	}
+	s2, w2 := sum*sum, side*side
+	return math.Max(w2*max/s2, s2/(w2*min))
+}
+
+// treemapLabelPad is the space between the label
+// of a Treemap cell and the edges of the cell.
+const treemapLabelPad = vg.Length(2)
+
+// Plot implements the plot.Plotter interface.
+func (t *Treemap) Plot(c draw.Canvas, plt *plot.Plot) {
+	trX, trY := plt.Transforms(&c)
+	r := vg.Rectangle{
+		Min: vg.Point{X: trX(0), Y: trY(0)},
+		Max: vg.Point{X: trX(1), Y: trY(1)},
+	}
+	cells := t.layout(r)
+	for _, cell := range cells {
+		pts := []vg.Point{
+			cell.Min,
+			{X: cell.Max.X, Y: cell.Min.Y},
+			cell.Max,
+			{X: cell.Min.X, Y: cell.Max.Y},
+		}
+		if col := t.color(cell); col != nil {
+			c.FillPolygon(col, c.ClipPolygonXY(pts))
+		}
+		c.StrokeLines(t.LineStyle, c.ClipLinesXY(append(pts, pts[0]))...)
+	}
+
+	for _, cell := range cells {
+		if len(cell.node.Children) != 0 || cell.node.Label == "" {
+			continue
+		}
+		size := cell.Size()
+		if t.LabelStyle.Height(cell.node.Label)+2*treemapLabelPad > size.Y {
+			continue
+		}
+		txt := fitText(t.LabelStyle, cell.node.Label, size.X-2*treemapLabelPad)
+		if txt == "" {
+			continue
+		}
+		pt := vg.Point{X: cell.Min.X + treemapLabelPad, Y: cell.Max.Y - treemapLabelPad}
+		c.FillText(t.LabelStyle, pt, txt)
+	}
+}
+
+// color returns the fill color of the cell,
+// or nil if the cell is not filled.
+func (t *Treemap) color(cell treemapCell) color.Color {
+	if t.ColorMap == nil {
+		return nil
+	}
+	v := float64(cell.depth)
+	if t.ColorByValue {
+		if len(cell.node.Children) != 0 {
+			return nil
+		}
+		v = cell.node.Value
+	}
+	col, err := t.ColorMap.At(v)
+	if err != nil {
+		return nil
+	}
+	return col
+}
+
+// fitText returns txt, shortened with an ellipsis if needed
+// to fit within the given width when drawn with sty. The empty
+// string is returned if no part of txt fits.
+func fitText(sty draw.TextStyle, txt string, width vg.Length) string {
+	if sty.Width(txt) <= width {
+		return txt
+	}
+	runes := []rune(txt)
+	for n := len(runes) - 1; n > 0; n-- {
+		short := string(runes[:n]) + "…"
+		if sty.Width(short) <= width {
+			return short
+		}
+	}
+	return ""
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (t *Treemap) DataRange() (xmin, xmax, ymin, ymax float64) {
+	return 0, 1, 0, 1
+}
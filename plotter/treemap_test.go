@@ -0,0 +1,160 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette/moreland"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleTreemap draws the disk usage of a directory tree.
+func ExampleTreemap() {
+	dir := func(name string, children ...*TreeNode) *TreeNode {
+		return &TreeNode{Label: name, Children: children}
+	}
+	file := func(name string, size float64) *TreeNode {
+		return &TreeNode{Label: name, Weight: size}
+	}
+	root := dir("/",
+		dir("usr",
+			dir("lib", file("libc.so", 2100), file("libm.so", 900), file("libssl.so", 700)),
+			dir("bin", file("go", 1500), file("git", 400), file("vim", 300)),
+			file("share", 800),
+		),
+		dir("home",
+			file("photos", 4000),
+			file("music", 2500),
+			dir("src", file("plot", 300), file("gonum", 600)),
+		),
+		dir("var", file("log", 700), file("cache", 500)),
+	)
+
+	tm, err := NewTreemap(root, moreland.SmoothBlueRed())
+	if err != nil {
+		log.Panic(err)
+	}
+	tm.Padding = vg.Points(3)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Disk usage"
+	p.Add(tm)
+	p.HideAxes()
+
+	err = p.Save(300, 250, "testdata/treemap.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestTreemap(t *testing.T) {
+	cmpimg.CheckPlot(ExampleTreemap, t, "treemap.png")
+}
+
+func TestSquarify(t *testing.T) {
+	// The example from Bruls, Huizing and van Wijk.
+	weights := []float64{6, 6, 4, 3, 2, 2, 1}
+	r := vg.Rectangle{Max: vg.Point{X: 6, Y: 4}}
+	rects := squarify(weights, r)
+
+	var area float64
+	for i, rr := range rects {
+		size := rr.Size()
+		a := float64(size.X * size.Y)
+		if math.Abs(a-weights[i]) > 1e-9 {
+			t.Errorf("unexpected area of rectangle %d: got:%v want:%v", i, a, weights[i])
+		}
+		if rr.Min.X < r.Min.X-1e-9 || rr.Min.Y < r.Min.Y-1e-9 || rr.Max.X > r.Max.X+1e-9 || rr.Max.Y > r.Max.Y+1e-9 {
+			t.Errorf("rectangle %d outside of the layout: %+v", i, rr)
+		}
+		area += a
+	}
+	if math.Abs(area-24) > 1e-9 {
+		t.Errorf("unexpected total area: got:%v want:24", area)
+	}
+
+	// The first row of the paper's layout holds the two
+	// largest rectangles, each 3×2, stacked on the left.
+	for i := 0; i < 2; i++ {
+		if size := rects[i].Size(); math.Abs(float64(size.X)-3) > 1e-9 || math.Abs(float64(size.Y)-2) > 1e-9 {
+			t.Errorf("unexpected size of rectangle %d: got:%v want:{3 2}", i, size)
+		}
+	}
+}
+
+func TestTreemapLayout(t *testing.T) {
+	root := &TreeNode{Children: []*TreeNode{
+		{Label: "a", Weight: 1},
+		{Label: "b", Children: []*TreeNode{{Weight: 1}, {Weight: 2}}},
+		{Label: "empty", Weight: 0},
+	}}
+	tm, err := NewTreemap(root, moreland.SmoothBlueRed())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if min, max := tm.ColorMap.Min(), tm.ColorMap.Max(); min != 0 || max != 2 {
+		t.Errorf("unexpected color map range: got:[%v, %v] want:[0, 2]", min, max)
+	}
+	tm.Padding = 1
+	cells := tm.layout(vg.Rectangle{Max: vg.Point{X: 10, Y: 10}})
+	if len(cells) != 5 {
+		t.Fatalf("unexpected number of cells: got:%d want:5", len(cells))
+	}
+	for _, cell := range cells[1:] {
+		if cell.Min.X < 1 || cell.Min.Y < 1 || cell.Max.X > 9 || cell.Max.Y > 9 {
+			t.Errorf("cell %q outside of padded root: %+v", cell.node.Label, cell.Rectangle)
+		}
+	}
+	for _, cell := range cells {
+		if cell.node.Label != "a" {
+			continue
+		}
+		size := cell.Size()
+		if cell.depth != 1 || math.Abs(float64(size.X*size.Y)-64.0/4) > 1e-9 {
+			t.Errorf("unexpected cell for a: %+v", cell)
+		}
+	}
+
+	for _, root := range []*TreeNode{
+		nil,
+		{Weight: 0},
+		{Children: []*TreeNode{{Weight: -1}, {Weight: 2}}},
+		{Children: []*TreeNode{{Weight: math.Inf(1)}}},
+		{Children: []*TreeNode{nil}},
+	} {
+		if _, err := NewTreemap(root, nil); err == nil {
+			t.Errorf("expected error for tree %+v", root)
+		}
+	}
+}
+
+func TestFitText(t *testing.T) {
+	fnt, err := vg.MakeFont(DefaultFont, DefaultFontSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tm := Treemap{}
+	tm.LabelStyle.Font = fnt
+	sty := tm.LabelStyle
+	txt := "treemap"
+	if got := fitText(sty, txt, sty.Width(txt)); got != txt {
+		t.Errorf("unexpected text: got:%q want:%q", got, txt)
+	}
+	got := fitText(sty, txt, sty.Width(txt)-1)
+	if got == "" || got == txt || sty.Width(got) > sty.Width(txt)-1 {
+		t.Errorf("unexpected shortened text: %q", got)
+	}
+	if got := fitText(sty, txt, 0); got != "" {
+		t.Errorf("unexpected text for zero width: %q", got)
+	}
+}
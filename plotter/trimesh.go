@@ -0,0 +1,314 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// DefaultSubdivisions is the default number of
+// subdivisions of each edge of a TriMesh triangle.
+var DefaultSubdivisions = 8
+
+// TriMesh implements the Plotter interface, drawing the
+// triangles of a Triangulation colored by the Z values
+// of the points, which are linearly interpolated across
+// each triangle.
+type TriMesh struct {
+	*Triangulation
+
+	// Palette is the color palette used to render
+	// the mesh. Palette must not be nil or return
+	// a zero length []color.Color.
+	Palette palette.Palette
+
+	// Underflow and Overflow are colors used to fill
+	// parts of the mesh outside the dynamic range
+	// defined by Min and Max.
+	Underflow color.Color
+	Overflow  color.Color
+
+	// Min and Max define the dynamic range of the
+	// mesh.
+	Min, Max float64
+
+	// Subdivisions is the number of parts each edge
+	// of a triangle is divided into when the triangle
+	// is filled. Each triangle is drawn as the square
+	// of Subdivisions smaller triangles, each filled
+	// with the color of its mean value, approximating
+	// Gouraud shading. If Subdivisions is less than
+	// one, each triangle is filled with a single color.
+	Subdivisions int
+
+	// EdgeStyle is the style of the triangle edges.
+	// If the width is zero, the edges are not drawn.
+	EdgeStyle draw.LineStyle
+}
+
+// NewTriMesh creates a new triangle mesh plotter for the given
+// triangulation, using the provided palette. The Min and Max
+// fields are set to the range of the Z values of the points.
+func NewTriMesh(t *Triangulation, p palette.Palette) *TriMesh {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, p := range t.XYZs {
+		min = math.Min(min, p.Z)
+		max = math.Max(max, p.Z)
+	}
+	return &TriMesh{
+		Triangulation: t,
+		Palette:       p,
+		Min:           min,
+		Max:           max,
+		Subdivisions:  DefaultSubdivisions,
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (m *TriMesh) Plot(c draw.Canvas, plt *plot.Plot) {
+	if m.Min > m.Max {
+		panic("plotter: negative Z range in TriMesh")
+	}
+	pal := m.Palette.Colors()
+	if len(pal) == 0 {
+		panic("plotter: empty palette")
+	}
+	// ps scales the palette uniformly across the data range.
+	ps := float64(len(pal)-1) / (m.Max - m.Min)
+	if m.Max == m.Min {
+		ps = 0
+	}
+
+	trX, trY := plt.Transforms(&c)
+	n := m.Subdivisions
+	if n < 1 {
+		n = 1
+	}
+	fill := func(a, b, d contourVertex) {
+		var col color.Color
+		switch v := (a.Z + b.Z + d.Z) / 3; {
+		case v < m.Min:
+			col = m.Underflow
+		case v > m.Max:
+			col = m.Overflow
+		default:
+			col = pal[int((v-m.Min)*ps+0.5)] // Apply palette scaling.
+		}
+		if col == nil {
+			return
+		}
+		pts := c.ClipPolygonXY([]vg.Point{
+			{X: trX(a.X), Y: trY(a.Y)},
+			{X: trX(b.X), Y: trY(b.Y)},
+			{X: trX(d.X), Y: trY(d.Y)},
+		})
+		c.FillPolygon(col, pts)
+	}
+
+	for _, t := range m.Triangles {
+		a, b, d := m.vertex(t[0]), m.vertex(t[1]), m.vertex(t[2])
+
+		// at returns the point at i/n of the way from a
+		// to b and j/n of the way from a to d.
+		at := func(i, j int) contourVertex {
+			u, v := float64(i)/float64(n), float64(j)/float64(n)
+			return contourVertex{
+				X: a.X + u*(b.X-a.X) + v*(d.X-a.X),
+				Y: a.Y + u*(b.Y-a.Y) + v*(d.Y-a.Y),
+				Z: a.Z + u*(b.Z-a.Z) + v*(d.Z-a.Z),
+			}
+		}
+		for i := 0; i < n; i++ {
+			for j := 0; i+j < n; j++ {
+				fill(at(i, j), at(i+1, j), at(i, j+1))
+				if i+j < n-1 {
+					fill(at(i+1, j), at(i+1, j+1), at(i, j+1))
+				}
+			}
+		}
+	}
+
+	if m.EdgeStyle.Width == 0 {
+		return
+	}
+	for _, t := range m.Triangles {
+		pts := make([]vg.Point, 4)
+		for i := range pts {
+			p := m.XYZs[t[i%3]]
+			pts[i] = vg.Point{X: trX(p.X), Y: trY(p.Y)}
+		}
+		c.StrokeLines(m.EdgeStyle, c.ClipLinesXY(pts)...)
+	}
+}
+
+// vertex returns the ith point of the triangulation.
+func (t *Triangulation) vertex(i int) contourVertex {
+	p := t.XYZs[i]
+	return contourVertex{X: p.X, Y: p.Y, Z: p.Z}
+}
+
+// TriContour implements the Plotter interface, drawing
+// contour lines of the Z values of a Triangulation. The
+// values are linearly interpolated across each triangle.
+type TriContour struct {
+	*Triangulation
+
+	// Levels describes the contour heights to plot.
+	Levels []float64
+
+	// LineStyles is the set of styles for contour
+	// lines. Line styles are are applied to each level
+	// in order, modulo the length of LineStyles.
+	LineStyles []draw.LineStyle
+
+	// Palette is the color palette used to render
+	// the contours. If Palette is nil or has no
+	// defined color, the TriContour LineStyle color
+	// is used.
+	Palette palette.Palette
+
+	// Underflow and Overflow are colors used to draw
+	// contours outside the dynamic range defined
+	// by Min and Max.
+	Underflow color.Color
+	Overflow  color.Color
+
+	// Min and Max define the dynamic range of the
+	// contours.
+	Min, Max float64
+}
+
+// NewTriContour creates a new contour plotter for the given
+// triangulation, using the provided palette. If levels is nil,
+// contours are generated for the 0.01, 0.05, 0.25, 0.5, 0.75,
+// 0.95 and 0.99 quantiles of the Z values. The Min and Max fields
+// are set to the range of the Z values.
+func NewTriContour(t *Triangulation, levels []float64, p palette.Palette) *TriContour {
+	z := make([]float64, len(t.XYZs))
+	for i, p := range t.XYZs {
+		z[i] = p.Z
+	}
+	sort.Float64s(z)
+	if len(levels) == 0 {
+		levels = make([]float64, len(defaultQuantiles))
+		for i, q := range defaultQuantiles {
+			levels[i] = QuantileR7.Quantile(z, q)
+		}
+	}
+	return &TriContour{
+		Triangulation: t,
+		Levels:        levels,
+		LineStyles:    []draw.LineStyle{DefaultLineStyle},
+		Palette:       p,
+		Min:           z[0],
+		Max:           z[len(z)-1],
+	}
+}
+
+// Plot implements the Plot method of the plot.Plotter interface.
+func (h *TriContour) Plot(c draw.Canvas, plt *plot.Plot) {
+	if len(h.Levels) == 0 {
+		return
+	}
+	var pal []color.Color
+	if h.Palette != nil {
+		pal = h.Palette.Colors()
+	}
+
+	trX, trY := plt.Transforms(&c)
+	cp := triContourPaths(h.Triangulation, h.Levels, trX, trY)
+
+	// ps is a palette scaling factor to scale the palette uniformly
+	// across the given levels. Sorting is not necessary since
+	// triContourPaths sorts the levels as a side effect. A single
+	// level, or levels that are all equal, take the first color.
+	var ps float64
+	if rng := h.Levels[len(h.Levels)-1] - h.Levels[0]; rng > 0 {
+		ps = float64(len(pal)-1) / rng
+	}
+
+	for i, z := range h.Levels {
+		if math.IsNaN(z) {
+			continue
+		}
+		style := h.LineStyles[i%len(h.LineStyles)]
+		var col color.Color
+		switch {
+		case z < h.Min:
+			col = h.Underflow
+		case z > h.Max:
+			col = h.Overflow
+		case len(pal) == 0:
+			col = style.Color
+		default:
+			col = pal[int((z-h.Levels[0])*ps+0.5)] // Apply palette scaling.
+		}
+		if col == nil || style.Width == 0 {
+			continue
+		}
+		c.SetLineStyle(style)
+		c.SetColor(col)
+		for _, pa := range cp[z] {
+			if isLoop(pa) {
+				pa.Close()
+			}
+			c.Stroke(pa)
+		}
+	}
+}
+
+// triContourPaths returns a collection of vg.Paths describing contour
+// lines of the triangulation t cut at the given levels, in the same
+// form as is returned by contourPaths. triContourPaths sorts levels
+// ascending as a side effect.
+func triContourPaths(t *Triangulation, levels []float64, trX, trY func(float64) vg.Length) map[float64][]vg.Path {
+	sort.Float64s(levels)
+
+	ends := make(map[float64]endMap)
+	conts := make(contourSet)
+	for _, z := range levels {
+		if math.IsNaN(z) {
+			continue
+		}
+		for _, tri := range t.Triangles {
+			if l, ok := t.isoLine(tri, z); ok {
+				paths(l, z, ends, conts)
+			}
+		}
+	}
+	return contourSetPaths(conts, trX, trY)
+}
+
+// isoLine returns the line where the linear interpolation of the
+// Z values across the triangle tri crosses the height z. Vertices
+// at the height z are taken to be above it. The ends of the line
+// are calculated from the vertices of the edges they lie on, in
+// index order, so that triangles sharing an edge share line ends.
+func (t *Triangulation) isoLine(tri [3]int, z float64) (l line, ok bool) {
+	var ends []point
+	for k := range tri {
+		i, j := tri[k], tri[(k+1)%3]
+		if i > j {
+			i, j = j, i
+		}
+		a, b := t.XYZs[i], t.XYZs[j]
+		if (a.Z >= z) == (b.Z >= z) {
+			continue
+		}
+		f := (z - a.Z) / (b.Z - a.Z)
+		ends = append(ends, point{X: a.X + f*(b.X-a.X), Y: a.Y + f*(b.Y-a.Y)})
+	}
+	if len(ends) != 2 || ends[0] == ends[1] {
+		return line{}, false
+	}
+	return line{p1: ends[0], p2: ends[1]}, true
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"math"
+	"math/rand"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/palette"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"gonum.org/v1/plot/vg/recorder"
+)
+
+func ExampleTriMesh() {
+	// Measurements of a surface taken
+	// at scattered locations.
+	rnd := rand.New(rand.NewSource(1))
+	data := make(XYZs, 150)
+	for i := range data {
+		x, y := 4*rnd.Float64()-2, 4*rnd.Float64()-2
+		data[i].X, data[i].Y = x, y
+		data[i].Z = x * math.Exp(-x*x-y*y)
+	}
+
+	tri, err := NewTriangulation(data)
+	if err != nil {
+		log.Panic(err)
+	}
+	m := NewTriMesh(tri, palette.Heat(32, 1))
+	m.EdgeStyle = DefaultLineStyle
+	m.EdgeStyle.Width = vg.Points(0.1)
+	c := NewTriContour(tri, []float64{-0.3, -0.2, -0.1, 0, 0.1, 0.2, 0.3}, nil)
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Scattered data"
+	p.Add(m, c, NewGrid())
+	err = p.Save(300, 300, "testdata/triMesh.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestTriMesh(t *testing.T) {
+	cmpimg.CheckPlot(ExampleTriMesh, t, "triMesh.png")
+}
+
+func TestTriContourPaths(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := XYZs{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 4}, {X: 0, Y: 4}}
+	for i := 0; i < 50; i++ {
+		data = append(data, struct{ X, Y, Z float64 }{X: 4 * rnd.Float64(), Y: 4 * rnd.Float64()})
+	}
+	for i := range data {
+		data[i].Z = data[i].X
+	}
+	tri, err := NewTriangulation(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The contours of a plane are single straight
+	// lines crossing the triangulation.
+	levels := []float64{3, 1.5}
+	paths := triContourPaths(tri, levels, unity, unity)
+	if levels[0] != 1.5 {
+		t.Errorf("levels not sorted: %v", levels)
+	}
+	for _, z := range levels {
+		if len(paths[z]) != 1 {
+			t.Fatalf("unexpected number of paths for level %v: got:%d want:1", z, len(paths[z]))
+		}
+		pa := paths[z][0]
+		ys := make([]float64, len(pa))
+		for i, comp := range pa {
+			if math.Abs(float64(comp.Pos.X)-z) > 1e-12 {
+				t.Errorf("unexpected point on contour %v: %v", z, comp.Pos)
+			}
+			ys[i] = float64(comp.Pos.Y)
+		}
+		min, max := Range(Values(ys))
+		if min != 0 || max != 4 {
+			t.Errorf("contour %v does not cross the triangulation: y range [%v, %v]", z, min, max)
+		}
+	}
+}
+
+func TestTriContourLevels(t *testing.T) {
+	data := XYZs{{X: 0, Y: 0, Z: 0}, {X: 1, Y: 0, Z: 1}, {X: 1, Y: 1, Z: 2}, {X: 0, Y: 1, Z: 3}}
+	tri, err := NewTriangulation(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c := NewTriContour(tri, nil, palette.Heat(4, 1))
+	for i, q := range defaultQuantiles {
+		if want := QuantileR7.Quantile([]float64{0, 1, 2, 3}, q); c.Levels[i] != want {
+			t.Errorf("unexpected default level %d: got:%v want:%v", i, c.Levels[i], want)
+		}
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p.Add(c)
+	for _, levels := range [][]float64{nil, {1.5}, {1.5, 1.5}} {
+		c.Levels = levels
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("unexpected panic for levels %v: %v", levels, r)
+				}
+			}()
+			var rec recorder.Canvas
+			c.Plot(draw.NewCanvas(&rec, 100, 100), p)
+		}()
+	}
+}
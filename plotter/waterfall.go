@@ -0,0 +1,229 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+)
+
+// A Waterfall presents a sequence of changes to a value as
+// bars, each starting where the running total of the changes
+// before it ended. Subtotal bars may be placed between the
+// changes to show the running total from zero.
+type Waterfall struct {
+	// Values holds the changes.
+	Values
+
+	// Base is the value before the first change.
+	Base float64
+
+	// Subtotals holds the number of changes
+	// after which each subtotal bar is drawn.
+	// A subtotal after all the changes shows
+	// the final total.
+	Subtotals []int
+
+	// Width is the width of the bars.
+	Width vg.Length
+
+	// IncreaseColor, DecreaseColor and SubtotalColor
+	// are the fill colors of the bars of positive and
+	// negative changes, and of the subtotals.
+	IncreaseColor color.Color
+	DecreaseColor color.Color
+	SubtotalColor color.Color
+
+	// LineStyle is the style of the outline of the bars.
+	draw.LineStyle
+
+	// ConnectorStyle is the style of the lines joining
+	// the end of each bar to the start of the next. If
+	// the width is zero, no connectors are drawn.
+	ConnectorStyle draw.LineStyle
+
+	// Offset is added to the X location of each bar.
+	// When the Offset is zero, the bars are drawn
+	// centered at their X location.
+	Offset vg.Length
+
+	// XMin is the X location of the first bar.
+	XMin float64
+
+	// Horizontal dictates whether the bars should be in the vertical
+	// (default) or horizontal direction. If Horizontal is true, all
+	// X locations and distances referred to here will actually be Y
+	// locations and distances.
+	Horizontal bool
+}
+
+// NewWaterfall returns a new waterfall chart with a bar for each
+// change in vs, placed at the X locations 0, 1, … in order.
+func NewWaterfall(vs Valuer, width vg.Length) (*Waterfall, error) {
+	if width <= 0 {
+		return nil, errors.New("plotter: width parameter was not positive")
+	}
+	values, err := CopyValues(vs)
+	if err != nil {
+		return nil, err
+	}
+	return &Waterfall{
+		Values:        values,
+		Width:         width,
+		IncreaseColor: color.RGBA{G: 160, A: 255},
+		DecreaseColor: color.RGBA{R: 200, A: 255},
+		SubtotalColor: color.Gray{Y: 128},
+		LineStyle:     DefaultLineStyle,
+	}, nil
+}
+
+// AddSubtotal adds a subtotal bar after the first n changes.
+// An error is returned if n is greater than the number of
+// changes.
+func (w *Waterfall) AddSubtotal(n int) error {
+	if n < 0 || n > len(w.Values) {
+		return fmt.Errorf("plotter: subtotal after %d of %d changes", n, len(w.Values))
+	}
+	w.Subtotals = append(w.Subtotals, n)
+	return nil
+}
+
+// waterfallBar is a bar of a Waterfall,
+// spanning the values from start to end.
+type waterfallBar struct {
+	start, end float64
+	subtotal   bool
+}
+
+// bars returns the start and end value of each bar in the
+// order they are drawn, including the subtotal bars.
+func (w *Waterfall) bars() []waterfallBar {
+	subtotals := append([]int(nil), w.Subtotals...)
+	sort.Ints(subtotals)
+
+	bars := make([]waterfallBar, 0, len(w.Values)+len(subtotals))
+	total := w.Base
+	for i := 0; i <= len(w.Values); i++ {
+		for len(subtotals) > 0 && subtotals[0] == i {
+			bars = append(bars, waterfallBar{end: total, subtotal: true})
+			subtotals = subtotals[1:]
+		}
+		if i == len(w.Values) {
+			break
+		}
+		bars = append(bars, waterfallBar{start: total, end: total + w.Values[i]})
+		total += w.Values[i]
+	}
+	return bars
+}
+
+// Plot implements the plot.Plotter interface.
+func (w *Waterfall) Plot(c draw.Canvas, plt *plot.Plot) {
+	trCat, trVal := plt.Transforms(&c)
+	if w.Horizontal {
+		trCat, trVal = trVal, trCat
+	}
+	point := func(cat, val vg.Length) vg.Point {
+		if w.Horizontal {
+			return vg.Point{X: val, Y: cat}
+		}
+		return vg.Point{X: cat, Y: val}
+	}
+
+	bars := w.bars()
+	for i, b := range bars {
+		catMin := trCat(w.XMin+float64(i)) - w.Width/2 + w.Offset
+		catMax := catMin + w.Width
+		valMin, valMax := trVal(b.start), trVal(b.end)
+
+		col := w.IncreaseColor
+		switch {
+		case b.subtotal:
+			col = w.SubtotalColor
+		case b.end < b.start:
+			col = w.DecreaseColor
+		}
+		pts := []vg.Point{
+			point(catMin, valMin),
+			point(catMin, valMax),
+			point(catMax, valMax),
+			point(catMax, valMin),
+		}
+		c.FillPolygon(col, c.ClipPolygonXY(pts))
+		c.StrokeLines(w.LineStyle, c.ClipLinesXY(append(pts, pts[0]))...)
+
+		if w.ConnectorStyle.Width == 0 || i == len(bars)-1 {
+			continue
+		}
+		next := trCat(w.XMin+float64(i+1)) - w.Width/2 + w.Offset
+		line := []vg.Point{point(catMax, valMax), point(next, valMax)}
+		c.StrokeLines(w.ConnectorStyle, c.ClipLinesXY(line)...)
+	}
+}
+
+// DataRange implements the plot.DataRanger interface.
+func (w *Waterfall) DataRange() (xmin, xmax, ymin, ymax float64) {
+	bars := w.bars()
+	catMin := w.XMin
+	catMax := catMin + float64(len(bars)-1)
+
+	valMin := math.Inf(1)
+	valMax := math.Inf(-1)
+	for _, b := range bars {
+		valMin = math.Min(valMin, math.Min(b.start, b.end))
+		valMax = math.Max(valMax, math.Max(b.start, b.end))
+	}
+
+	if w.Horizontal {
+		return valMin, valMax, catMin, catMax
+	}
+	return catMin, catMax, valMin, valMax
+}
+
+// GlyphBoxes implements the GlyphBoxer interface.
+func (w *Waterfall) GlyphBoxes(plt *plot.Plot) []plot.GlyphBox {
+	boxes := make([]plot.GlyphBox, len(w.Values)+len(w.Subtotals))
+	for i := range boxes {
+		cat := w.XMin + float64(i)
+		if !w.Horizontal {
+			boxes[i].X = plt.X.Norm(cat)
+			boxes[i].Rectangle = vg.Rectangle{
+				Min: vg.Point{X: w.Offset - w.Width/2},
+				Max: vg.Point{X: w.Offset + w.Width/2},
+			}
+		} else {
+			boxes[i].Y = plt.Y.Norm(cat)
+			boxes[i].Rectangle = vg.Rectangle{
+				Min: vg.Point{Y: w.Offset - w.Width/2},
+				Max: vg.Point{Y: w.Offset + w.Width/2},
+			}
+		}
+	}
+	return boxes
+}
+
+// Thumbnailers returns the labels and thumbnailers of
+// the increase, decrease and subtotal bars, to be used
+// to add legend entries for them. The subtotal entry is
+// only returned if the chart has subtotal bars.
+func (w *Waterfall) Thumbnailers() (legendLabels []string, thumbnailers []plot.Thumbnailer) {
+	legendLabels = []string{"Increase", "Decrease"}
+	cols := []color.Color{w.IncreaseColor, w.DecreaseColor}
+	if len(w.Subtotals) != 0 {
+		legendLabels = append(legendLabels, "Subtotal")
+		cols = append(cols, w.SubtotalColor)
+	}
+	for _, col := range cols {
+		thumbnailers = append(thumbnailers, &BarChart{Color: col, LineStyle: w.LineStyle})
+	}
+	return legendLabels, thumbnailers
+}
@@ -0,0 +1,102 @@
+// Copyright ©2026 The gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package plotter
+
+import (
+	"log"
+	"testing"
+
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/internal/cmpimg"
+	"gonum.org/v1/plot/vg"
+)
+
+// ExampleWaterfall breaks down the latency
+// budget of a request into its stages.
+func ExampleWaterfall() {
+	stages := Values{12, 35, -8, 20, 6, -15}
+	w, err := NewWaterfall(stages, vg.Points(20))
+	if err != nil {
+		log.Panic(err)
+	}
+	w.ConnectorStyle = DefaultLineStyle
+	w.ConnectorStyle.Dashes = []vg.Length{vg.Points(2), vg.Points(2)}
+	err = w.AddSubtotal(3)
+	if err != nil {
+		log.Panic(err)
+	}
+	err = w.AddSubtotal(len(stages))
+	if err != nil {
+		log.Panic(err)
+	}
+
+	p, err := plot.New()
+	if err != nil {
+		log.Panic(err)
+	}
+	p.Title.Text = "Latency budget"
+	p.Y.Label.Text = "ms"
+	p.Add(w, NewGrid())
+	p.NominalX("DNS", "TLS", "cache", "network", "server", "queue", "render", "total")
+	labels, thumbs := w.Thumbnailers()
+	for i, l := range labels {
+		p.Legend.Add(l, thumbs[i])
+	}
+	p.Legend.Top = true
+	p.Legend.Left = true
+
+	err = p.Save(300, 200, "testdata/waterfall.png")
+	if err != nil {
+		log.Panic(err)
+	}
+}
+
+func TestWaterfall(t *testing.T) {
+	cmpimg.CheckPlot(ExampleWaterfall, t, "waterfall.png")
+}
+
+func TestWaterfallBars(t *testing.T) {
+	w, err := NewWaterfall(Values{3, -1, 2}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w.Base = 10
+	for _, n := range []int{3, 2, 0} {
+		if err := w.AddSubtotal(n); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := w.AddSubtotal(4); err == nil {
+		t.Error("expected error for subtotal after too many changes")
+	}
+
+	want := []waterfallBar{
+		{end: 10, subtotal: true},
+		{start: 10, end: 13},
+		{start: 13, end: 12},
+		{end: 12, subtotal: true},
+		{start: 12, end: 14},
+		{end: 14, subtotal: true},
+	}
+	got := w.bars()
+	if len(got) != len(want) {
+		t.Fatalf("unexpected number of bars: got:%d want:%d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("unexpected bar %d: got:%+v want:%+v", i, got[i], want[i])
+		}
+	}
+
+	xmin, xmax, ymin, ymax := w.DataRange()
+	if xmin != 0 || xmax != 5 || ymin != 0 || ymax != 14 {
+		t.Errorf("unexpected data range: got:[%v, %v]×[%v, %v] want:[0, 5]×[0, 14]", xmin, xmax, ymin, ymax)
+	}
+	w.Horizontal = true
+	xmin, xmax, ymin, ymax = w.DataRange()
+	if xmin != 0 || xmax != 14 || ymin != 0 || ymax != 5 {
+		t.Errorf("unexpected horizontal data range: got:[%v, %v]×[%v, %v] want:[0, 14]×[0, 5]", xmin, xmax, ymin, ymax)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/palette"
 	"gonum.org/v1/plot/plotter"
 	"gonum.org/v1/plot/vg"
 )
@@ -122,6 +123,74 @@ func AddBoxPlots(plt *plot.Plot, width vg.Length, vs ...interface{}) error {
 	return nil
 }
 
+// AddGroupedBars adds a grouped bar chart to a plot.
+// The variadic arguments must be either strings
+// or plotter.Valuers.  Each plotter.Valuer adds a
+// series of bars placed beside the bars of the
+// series added before it, using the next color via
+// the Color function.  If a plotter.Valuer is
+// immediately preceeded by a string then a legend
+// entry is added to the plot using the string as
+// the name.
+//
+// If an error occurs then none of the plotters are added
+// to the plot, and the error is returned.
+func AddGroupedBars(plt *plot.Plot, width vg.Length, vs ...interface{}) error {
+	return addBarGroup(plt, width, plotter.NewGroupedBars, "AddGroupedBars", vs)
+}
+
+// AddStackedBars adds a stacked bar chart to a plot.
+// The variadic arguments must be either strings
+// or plotter.Valuers.  Each plotter.Valuer adds a
+// series of bars stacked on the bars of the series
+// added before it, using the next color via the
+// Color function.  Positive and negative values are
+// stacked separately.  If a plotter.Valuer is
+// immediately preceeded by a string then a legend
+// entry is added to the plot using the string as
+// the name.
+//
+// If an error occurs then none of the plotters are added
+// to the plot, and the error is returned.
+func AddStackedBars(plt *plot.Plot, width vg.Length, vs ...interface{}) error {
+	return addBarGroup(plt, width, plotter.NewStackedBars, "AddStackedBars", vs)
+}
+
+func addBarGroup(plt *plot.Plot, width vg.Length, newGroup func(vg.Length, palette.Palette, ...plotter.BarSeries) (*plotter.BarGroup, error), fn string, vs []interface{}) error {
+	var series []plotter.BarSeries
+	name := ""
+	for _, v := range vs {
+		switch t := v.(type) {
+		case string:
+			name = t
+
+		case plotter.Valuer:
+			series = append(series, plotter.BarSeries{Name: name, Values: t})
+			name = ""
+
+		default:
+			panic(fmt.Sprintf("%s handles strings and plotter.Valuers, got %T", fn, t))
+		}
+	}
+
+	g, err := newGroup(width, nil, series...)
+	if err != nil {
+		return err
+	}
+	for i, b := range g.Bars {
+		b.Color = Color(i)
+	}
+
+	plt.Add(g)
+	names, thumbs := g.Thumbnailers()
+	for i, name := range names {
+		if name != "" {
+			plt.Legend.Add(name, thumbs[i])
+		}
+	}
+	return nil
+}
+
 // AddScatters adds Scatter plotters to a plot.
 // The variadic arguments must be either strings
 // or plotter.XYers.  Each plotter.XYer is added to
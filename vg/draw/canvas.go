@@ -194,6 +194,22 @@ func (BoxGlyph) DrawGlyph(c *Canvas, sty GlyphStyle, pt vg.Point) {
 	c.Fill(p)
 }
 
+// DiamondGlyph is a glyph that draws a filled square
+// standing on one corner.
+type DiamondGlyph struct{}
+
+// DrawGlyph implements the Glyph interface.
+func (DiamondGlyph) DrawGlyph(c *Canvas, sty GlyphStyle, pt vg.Point) {
+	r := sty.Radius + (sty.Radius-sty.Radius*cosπover4)/2
+	var p vg.Path
+	p.Move(vg.Point{X: pt.X, Y: pt.Y - r})
+	p.Line(vg.Point{X: pt.X + r, Y: pt.Y})
+	p.Line(vg.Point{X: pt.X, Y: pt.Y + r})
+	p.Line(vg.Point{X: pt.X - r, Y: pt.Y})
+	p.Close()
+	c.Fill(p)
+}
+
 // TriangleGlyph is a glyph that draws the outline of a triangle.
 type TriangleGlyph struct{}
 